@@ -20,6 +20,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
 	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
@@ -86,6 +87,14 @@ type ObjectParameters struct {
 	// +kubebuilder:validation:Enum=Orphan;Background;Foreground
 	// +kubebuilder:default=Background
 	DeletionPropagationPolicy metav1.DeletionPropagation `json:"deletionPropagationPolicy"`
+
+	// LateInitializePaths are the field paths of the manifest, e.g.
+	// spec.clusterIP or spec.ports[*].nodePort, that are late-initialized from
+	// the remote object when the LateInitialize management policy is enabled.
+	// A field is copied into the manifest only if it is unset there and its
+	// parent is set, so list items are never added.
+	// +optional
+	LateInitializePaths []string `json:"lateInitializePaths,omitempty"`
 }
 
 // ObjectObservation are the observable fields of a Object.
@@ -94,6 +103,48 @@ type ObjectObservation struct {
 	// +kubebuilder:validation:EmbeddedResource
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Manifest runtime.RawExtension `json:"manifest,omitempty"`
+
+	// DryRun is the outcome of the last dry run, if spec.dryRun is set.
+	// +optional
+	DryRun *DryRunResult `json:"dryRun,omitempty"`
+
+	// UID of the remote object. A remote object with another UID under the
+	// same name has been replaced, see spec.replacement.
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
+	// Name of the remote object the UID was observed for. A remote object
+	// under another name is not compared against the UID, e.g. when the
+	// manifest is renamed.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the remote object the UID was observed for.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ResourceVersion of the remote object as of the last observation.
+	// +optional
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Generation of the remote object as of the last observation.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+}
+
+// DryRunResult is the outcome of syncing an Object's manifest to the target
+// API server with all changes dry-run.
+type DryRunResult struct {
+	// Manifest is the object the target API server would have persisted.
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Manifest runtime.RawExtension `json:"manifest,omitempty"`
+
+	// Diff is a JSON merge patch (RFC 7386) from the current remote object,
+	// or from an empty object if it does not exist, to Manifest.
+	// +optional
+	Diff string `json:"diff,omitempty"`
 }
 
 // A ObjectSpec defines the desired state of a Object.
@@ -111,6 +162,71 @@ type ObjectSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	Watch bool `json:"watch,omitempty"`
+	// DryRun syncs the manifest to the target API server with all changes
+	// dry-run, so nothing is ever persisted. The object the API server would
+	// have persisted and the diff against the current remote object are
+	// reported in status.atProvider.dryRun. While set, the remote object is
+	// neither created, updated nor deleted.
+	// +optional
+	// +kubebuilder:default=false
+	DryRun bool `json:"dryRun,omitempty"`
+	// Replacement configures how a remote object that was replaced, i.e.
+	// deleted and recreated under the same name by someone else, is handled.
+	// +optional
+	Replacement Replacement `json:"replacement,omitempty"`
+	// ExternalDeletionPolicy defines what happens when the remote object is
+	// deleted by someone other than this provider. Every such deletion is
+	// recorded as a Warning event and counted in the
+	// provider_kubernetes_external_deletions_total metric.
+	// +optional
+	// +kubebuilder:validation:Enum=Recreate;Report;DeleteObject
+	// +kubebuilder:default=Recreate
+	ExternalDeletionPolicy ExternalDeletionPolicy `json:"externalDeletionPolicy,omitempty"`
+}
+
+// ExternalDeletionPolicy defines how an externally deleted remote object is
+// handled.
+type ExternalDeletionPolicy string
+
+const (
+	// ExternalDeletionPolicyRecreate means the remote object is created again.
+	ExternalDeletionPolicyRecreate ExternalDeletionPolicy = "Recreate"
+	// ExternalDeletionPolicyReport means the remote object is left deleted,
+	// and the deletion is reported in the RemoteDeleted condition.
+	ExternalDeletionPolicyReport ExternalDeletionPolicy = "Report"
+	// ExternalDeletionPolicyDeleteObject means the Object is deleted too.
+	ExternalDeletionPolicyDeleteObject ExternalDeletionPolicy = "DeleteObject"
+)
+
+// ReplacementPolicy defines how a replaced remote object is handled.
+type ReplacementPolicy string
+
+const (
+	// ReplacementPolicyAdopt means the new remote object is managed in place
+	// of the replaced one.
+	ReplacementPolicyAdopt ReplacementPolicy = "Adopt"
+	// ReplacementPolicyRefuse means the Object stops reconciling with an
+	// error, and the new remote object is left alone when the Object is
+	// deleted.
+	ReplacementPolicyRefuse ReplacementPolicy = "Refuse"
+)
+
+// Replacement configures how a replaced remote object is handled. A remote
+// object is replaced if its UID differs from the one in
+// status.atProvider.uid.
+type Replacement struct {
+	// Policy defines how a replaced remote object is handled.
+	// +optional
+	// +kubebuilder:validation:Enum=Adopt;Refuse
+	// +kubebuilder:default=Adopt
+	Policy ReplacementPolicy `json:"policy,omitempty"`
+
+	// UpdatePrecondition makes updates fail unless the remote object still
+	// has the UID it had when it was last observed, so that an object that
+	// was replaced in the meantime is never updated. Deletes are always
+	// preconditioned on the UID.
+	// +optional
+	UpdatePrecondition bool `json:"updatePrecondition,omitempty"`
 }
 
 // ReadinessPolicy defines how the Object's readiness condition should be computed.
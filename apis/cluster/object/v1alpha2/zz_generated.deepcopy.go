@@ -55,6 +55,22 @@ func (in *DependsOn) DeepCopy() *DependsOn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResult) DeepCopyInto(out *DryRunResult) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResult.
+func (in *DryRunResult) DeepCopy() *DryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Object) DeepCopyInto(out *Object) {
 	*out = *in
@@ -118,6 +134,11 @@ func (in *ObjectList) DeepCopyObject() runtime.Object {
 func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
 	*out = *in
 	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
@@ -134,6 +155,11 @@ func (in *ObjectObservation) DeepCopy() *ObjectObservation {
 func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
 	*out = *in
 	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.LateInitializePaths != nil {
+		in, out := &in.LateInitializePaths, &out.LateInitializePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
@@ -164,6 +190,7 @@ func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
 		}
 	}
 	out.Readiness = in.Readiness
+	out.Replacement = in.Replacement
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
@@ -258,3 +285,18 @@ func (in *Reference) DeepCopy() *Reference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Replacement) DeepCopyInto(out *Replacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Replacement.
+func (in *Replacement) DeepCopy() *Replacement {
+	if in == nil {
+		return nil
+	}
+	out := new(Replacement)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+)
+
+// TypeDegraded indicates whether an ObservedObjectCollection matches more
+// objects than it may have members.
+const TypeDegraded xpv2.ConditionType = "Degraded"
+
+// Reasons an ObservedObjectCollection is or is not degraded.
+const (
+	ReasonMaxMembersExceeded xpv2.ConditionReason = "MaxMembersExceeded"
+	ReasonMembersTracked     xpv2.ConditionReason = "MembersTracked"
+)
+
+// MaxMembersExceeded returns a condition that indicates the collection
+// matches more objects than its maximum number of members, so that its
+// members are no longer updated.
+func MaxMembersExceeded(msg string) xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMaxMembersExceeded,
+		Message:            msg,
+	}
+}
+
+// MembersTracked returns a condition that indicates every object matched by
+// the collection is a member.
+func MembersTracked() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMembersTracked,
+	}
+}
@@ -21,13 +21,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	"github.com/crossplane/crossplane/apis/v2/core/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObserveObjectCriteria) DeepCopyInto(out *ObserveObjectCriteria) {
 	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
+	if in.OwnerSelector != nil {
+		in, out := &in.OwnerSelector, &out.OwnerSelector
+		*out = new(OwnerSelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObserveObjectCriteria.
@@ -103,7 +116,24 @@ func (in *ObservedObjectCollectionList) DeepCopyObject() runtime.Object {
 func (in *ObservedObjectCollectionSpec) DeepCopyInto(out *ObservedObjectCollectionSpec) {
 	*out = *in
 	in.ObserveObjects.DeepCopyInto(&out.ObserveObjects)
+	if in.AdditionalObserveObjects != nil {
+		in, out := &in.AdditionalObserveObjects, &out.AdditionalObserveObjects
+		*out = make([]ObserveObjectCriteria, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ProviderConfigReference.DeepCopyInto(&out.ProviderConfigReference)
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxMembers != nil {
+		in, out := &in.MaxMembers, &out.MaxMembers
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Template != nil {
 		in, out := &in.Template, &out.Template
 		*out = new(ObservedObjectTemplate)
@@ -132,6 +162,11 @@ func (in *ObservedObjectCollectionStatus) DeepCopyInto(out *ObservedObjectCollec
 			(*out)[key] = val
 		}
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ObservedObjectMember, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectCollectionStatus.
@@ -144,6 +179,36 @@ func (in *ObservedObjectCollectionStatus) DeepCopy() *ObservedObjectCollectionSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedObjectConnectionDetail) DeepCopyInto(out *ObservedObjectConnectionDetail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectConnectionDetail.
+func (in *ObservedObjectConnectionDetail) DeepCopy() *ObservedObjectConnectionDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedObjectConnectionDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedObjectMember) DeepCopyInto(out *ObservedObjectMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectMember.
+func (in *ObservedObjectMember) DeepCopy() *ObservedObjectMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedObjectMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservedObjectReference) DeepCopyInto(out *ObservedObjectReference) {
 	*out = *in
@@ -159,10 +224,30 @@ func (in *ObservedObjectReference) DeepCopy() *ObservedObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedObjectSecretReference) DeepCopyInto(out *ObservedObjectSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectSecretReference.
+func (in *ObservedObjectSecretReference) DeepCopy() *ObservedObjectSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedObjectSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservedObjectTemplate) DeepCopyInto(out *ObservedObjectTemplate) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ObservedObjectTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectTemplate.
@@ -192,6 +277,16 @@ func (in *ObservedObjectTemplateMetadata) DeepCopyInto(out *ObservedObjectTempla
 			(*out)[key] = val
 		}
 	}
+	if in.DynamicLabels != nil {
+		in, out := &in.DynamicLabels, &out.DynamicLabels
+		*out = make([]ObservedObjectTemplateValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.DynamicAnnotations != nil {
+		in, out := &in.DynamicAnnotations, &out.DynamicAnnotations
+		*out = make([]ObservedObjectTemplateValue, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectTemplateMetadata.
@@ -203,3 +298,68 @@ func (in *ObservedObjectTemplateMetadata) DeepCopy() *ObservedObjectTemplateMeta
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedObjectTemplateSpec) DeepCopyInto(out *ObservedObjectTemplateSpec) {
+	*out = *in
+	if in.ManagementPolicies != nil {
+		in, out := &in.ManagementPolicies, &out.ManagementPolicies
+		*out = make(v2.ManagementPolicies, len(*in))
+		copy(*out, *in)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(v1alpha2.Readiness)
+		**out = **in
+	}
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = make([]ObservedObjectConnectionDetail, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteConnectionSecretToReference != nil {
+		in, out := &in.WriteConnectionSecretToReference, &out.WriteConnectionSecretToReference
+		*out = new(ObservedObjectSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectTemplateSpec.
+func (in *ObservedObjectTemplateSpec) DeepCopy() *ObservedObjectTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedObjectTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedObjectTemplateValue) DeepCopyInto(out *ObservedObjectTemplateValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedObjectTemplateValue.
+func (in *ObservedObjectTemplateValue) DeepCopy() *ObservedObjectTemplateValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedObjectTemplateValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnerSelector) DeepCopyInto(out *OwnerSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnerSelector.
+func (in *OwnerSelector) DeepCopy() *OwnerSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnerSelector)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -27,6 +27,17 @@ import (
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv2.ProviderConfigStatus `json:",inline"`
+
+	// PolicyViolations of the resources using this provider config. At most
+	// 100 violations are listed.
+	// +optional
+	PolicyViolations []kconfig.PolicyViolation `json:"policyViolations,omitempty"`
+
+	// PolicyViolationsTruncated is true if more resources violate the
+	// policies than are listed in policyViolations. The PolicyCompliant
+	// condition of each resource reports its violation in any case.
+	// +optional
+	PolicyViolationsTruncated bool `json:"policyViolationsTruncated,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -76,3 +87,23 @@ type ProviderConfigUsageList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []ProviderConfigUsage `json:"items"`
 }
+
+// GetPolicyViolations of this ProviderConfig.
+func (p *ProviderConfig) GetPolicyViolations() []kconfig.PolicyViolation {
+	return p.Status.PolicyViolations
+}
+
+// SetPolicyViolations of this ProviderConfig.
+func (p *ProviderConfig) SetPolicyViolations(v []kconfig.PolicyViolation) {
+	p.Status.PolicyViolations = v
+}
+
+// GetPolicyViolationsTruncated of this ProviderConfig.
+func (p *ProviderConfig) GetPolicyViolationsTruncated() bool {
+	return p.Status.PolicyViolationsTruncated
+}
+
+// SetPolicyViolationsTruncated of this ProviderConfig.
+func (p *ProviderConfig) SetPolicyViolationsTruncated(t bool) {
+	p.Status.PolicyViolationsTruncated = t
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+)
+
+// TypeManifestValid indicates whether the manifest of an Object conforms to
+// the OpenAPI schema served by the target cluster.
+const TypeManifestValid xpv2.ConditionType = "ManifestValid"
+
+// Reasons an Object's manifest is or is not valid.
+const (
+	ReasonManifestValid   xpv2.ConditionReason = "SchemaValidationSucceeded"
+	ReasonManifestInvalid xpv2.ConditionReason = "SchemaValidationFailed"
+)
+
+// ManifestValid returns a condition that indicates the manifest conforms to
+// the schema of its kind in the target cluster.
+func ManifestValid() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeManifestValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonManifestValid,
+	}
+}
+
+// ManifestInvalid returns a condition that indicates the manifest does not
+// conform to the schema of its kind in the target cluster. The supplied
+// message should list the offending field paths.
+func ManifestInvalid(msg string) xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeManifestValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonManifestInvalid,
+		Message:            msg,
+	}
+}
+
+// TypeDryRun indicates the outcome of the last dry run of an Object.
+const TypeDryRun xpv2.ConditionType = "DryRun"
+
+// Reasons for the outcome of a dry run.
+const (
+	ReasonDryRunCreate    xpv2.ConditionReason = "WouldCreate"
+	ReasonDryRunUpdate    xpv2.ConditionReason = "WouldUpdate"
+	ReasonDryRunNoChanges xpv2.ConditionReason = "NoChanges"
+	ReasonDryRunFailed    xpv2.ConditionReason = "DryRunFailed"
+	ReasonDryRunDisabled  xpv2.ConditionReason = "DryRunDisabled"
+)
+
+// DryRunSucceeded returns a condition that indicates the last dry run
+// succeeded, with a reason describing what would have been persisted.
+func DryRunSucceeded(r xpv2.ConditionReason) xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+	}
+}
+
+// DryRunFailed returns a condition that indicates the target API server
+// rejected the last dry run.
+func DryRunFailed(msg string) xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDryRunFailed,
+		Message:            msg,
+	}
+}
+
+// DryRunDisabled returns a condition that indicates dry run was turned off
+// and the Object is synced to the target API server again.
+func DryRunDisabled() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDryRunDisabled,
+	}
+}
+
+// TypeRemoteDeleted indicates whether the remote object of an Object was
+// deleted by someone other than this provider. It is only set when the
+// external deletion policy is Report.
+const TypeRemoteDeleted xpv2.ConditionType = "RemoteDeleted"
+
+// Reasons the remote object of an Object is or is not deleted.
+const (
+	ReasonRemoteDeletedExternally xpv2.ConditionReason = "DeletedExternally"
+	ReasonRemoteObserved          xpv2.ConditionReason = "Observed"
+)
+
+// RemoteDeleted returns a condition that indicates the remote object was
+// deleted externally and has not been recreated.
+func RemoteDeleted() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeRemoteDeleted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRemoteDeletedExternally,
+	}
+}
+
+// RemoteObserved returns a condition that indicates the remote object exists
+// again after it was deleted externally.
+func RemoteObserved() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypeRemoteDeleted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRemoteObserved,
+	}
+}
+
+// TypePolicyCompliant indicates whether the manifest of an Object complies
+// with the policies of its provider config. It is only set once an Object
+// violated a policy.
+const TypePolicyCompliant xpv2.ConditionType = "PolicyCompliant"
+
+// ReasonPolicyCompliant indicates an Object complies with the policies of its
+// provider config.
+const ReasonPolicyCompliant xpv2.ConditionReason = "Compliant"
+
+// PolicyCompliant returns a condition that indicates the manifest complies
+// with the policies of the provider config.
+func PolicyCompliant() xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypePolicyCompliant,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPolicyCompliant,
+	}
+}
+
+// PolicyViolated returns a condition that indicates the manifest violates a
+// policy of the provider config for the supplied reason.
+func PolicyViolated(r xpv2.ConditionReason, msg string) xpv2.Condition {
+	return xpv2.Condition{
+		Type:               TypePolicyCompliant,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	objectv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
 )
 
 // +kubebuilder:object:root=true
@@ -31,6 +33,8 @@ import (
 // +kubebuilder:printcolumn:name="PROVIDERCONFIG",type="string",JSONPath=".spec.providerConfigRef.name"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="MEMBERS",type="integer",JSONPath=".status.memberCount"
+// +kubebuilder:printcolumn:name="READY-MEMBERS",type="integer",JSONPath=".status.readyMemberCount",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,kubernetes}
 // +kubebuilder:validation:XValidation:rule="size(self.metadata.name) < 64",message="metadata.name max length is 63"
@@ -57,18 +61,75 @@ type ObservedObjectCollectionSpec struct {
 	// to become a member of this collection
 	ObserveObjects ObserveObjectCriteria `json:"observeObjects"`
 
+	// AdditionalObserveObjects declares further criteria, e.g. for other
+	// kinds. Objects that fulfil any of the criteria become members of this
+	// collection. Members are labelled with the index of the criteria they
+	// fulfil, where 0 is ObserveObjects and 1 the first additional criteria.
+	// The criteria are a separate field, rather than ObserveObjects turning
+	// into a list, so that existing collections stay valid. For the same
+	// reason, members of the kind of ObserveObjects keep the names they had
+	// before collections could observe multiple kinds, while the names of
+	// members of other kinds also contain their kind.
+	// +optional
+	// +kubebuilder:validation:MaxItems:=16
+	AdditionalObserveObjects []ObserveObjectCriteria `json:"additionalObserveObjects,omitempty"`
+
 	// ProviderConfigReference specifies how the provider that will be used to
 	// create, observe, update, and delete this managed resource should be
 	// configured.
 	// +kubebuilder:default={"name": "default", "kind": "ClusterProviderConfig"}
 	ProviderConfigReference xpv2.ProviderConfigReference `json:"providerConfigRef,omitempty"`
 
+	// Watch enables watching the matched kinds in the remote cluster, so
+	// that members are added and removed as soon as matching objects change.
+	// Changes of the status of objects are still only observed every poll
+	// interval. Requires the provider to run with --enable-watches.
+	// +optional
+	Watch bool `json:"watch,omitempty"`
+
+	// PollInterval is the interval at which the remote cluster is listed
+	// for matching objects. Defaults to the poll interval of the provider.
+	// +optional
+	PollInterval *v1.Duration `json:"pollInterval,omitempty"`
+
+	// MaxMembers is the maximum number of objects the criteria of the
+	// collection may match together. A collection matching more objects is
+	// marked as degraded, and its members are left as they are until it
+	// matches fewer objects again.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxMembers *int64 `json:"maxMembers,omitempty"`
+
+	// MemberDeletionPolicy specifies what happens to the member Objects when
+	// the collection is deleted. Delete deletes them before the collection
+	// goes away, while Orphan keeps them without their owner references and
+	// membership labels.
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	// +optional
+	MemberDeletionPolicy MemberDeletionPolicy `json:"memberDeletionPolicy,omitempty"`
+
+	// AdoptMembers turns every member into a fully managed Object, whose
+	// manifest is the current state of its matched object, and detaches it
+	// from the collection. A single member is adopted by annotating it with
+	// kubernetes.crossplane.io/adopt: "true". Adopted Objects are no longer
+	// members, even if their matched objects still match.
+	// +optional
+	AdoptMembers bool `json:"adoptMembers,omitempty"`
+
 	// Template when defined is used for creating Object instances
 	// +optional
 	Template *ObservedObjectTemplate `json:"objectTemplate,omitempty"`
 }
 
+// Criteria returns all criteria of the collection, starting with
+// ObserveObjects.
+func (s *ObservedObjectCollectionSpec) Criteria() []ObserveObjectCriteria {
+	return append([]ObserveObjectCriteria{s.ObserveObjects}, s.AdditionalObserveObjects...)
+}
+
 // ObserveObjectCriteria declares criteria for an object to be a part of collection
+// +kubebuilder:validation:XValidation:rule="!has(self.__namespace__) || !has(self.namespaceSelector)",message="namespace and namespaceSelector are mutually exclusive"
 type ObserveObjectCriteria struct {
 
 	// APIVersion of objects that should be matched by the selector
@@ -85,15 +146,145 @@ type ObserveObjectCriteria struct {
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 
+	// NamespaceSelector restricts the search to the namespaces of the remote
+	// cluster whose labels match it, e.g. all namespaces labelled env=prod.
+	// The namespaces are resolved on every reconcile and, if the collection
+	// is watched, whenever the labels of a namespace change. Mutually
+	// exclusive with namespace.
+	// +optional
+	NamespaceSelector *v1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// Selector defines the criteria for including objects into the collection
 	Selector v1.LabelSelector `json:"selector"`
+
+	// FieldSelector restricts the matched objects by their fields, e.g.
+	// spec.nodeName=node-1 for Pods or type=kubernetes.io/tls for Secrets.
+	// Only the fields the remote API server supports for the kind may be
+	// used.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+
+	// Filter is a CEL expression that is evaluated against each matched
+	// object, available as the variable 'object'. Only objects for which it
+	// evaluates to true become members, e.g. object.spec.type == 'LoadBalancer'.
+	// Objects it cannot be evaluated against do not become members, and are
+	// reported by a warning event. Existing members are not removed while the
+	// filter cannot be evaluated against all objects.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+
+	// OwnerSelector restricts the matched objects to children of the
+	// selected owner, as recorded by their owner references.
+	// +optional
+	OwnerSelector *OwnerSelector `json:"ownerSelector,omitempty"`
 }
 
+// OwnerSelector selects objects by the owners in their owner references
+type OwnerSelector struct {
+
+	// APIVersion of the owner. Owners of any API version are selected if
+	// omitted.
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the owner
+	// +kubebuilder:validation:MinLength:=1
+	Kind string `json:"kind"`
+
+	// Name of the owner. Owners of any name are selected if omitted.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// Matches returns true if any of the supplied owner references is selected.
+func (s *OwnerSelector) Matches(refs []v1.OwnerReference) bool {
+	for _, r := range refs {
+		if (s.APIVersion == "" || s.APIVersion == r.APIVersion) &&
+			s.Kind == r.Kind &&
+			(s.Name == "" || s.Name == r.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberDeletionPolicy specifies what happens to the member Objects of a
+// deleted collection.
+type MemberDeletionPolicy string
+
+const (
+	// MemberDeletionDelete deletes the member Objects of a deleted
+	// collection.
+	MemberDeletionDelete MemberDeletionPolicy = "Delete"
+	// MemberDeletionOrphan keeps the member Objects of a deleted collection.
+	MemberDeletionOrphan MemberDeletionPolicy = "Orphan"
+)
+
 // ObservedObjectTemplate represents template used when creating observe-only Objects matching the given selector
 type ObservedObjectTemplate struct {
 
 	// Objects metadata
 	Metadata ObservedObjectTemplateMetadata `json:"metadata,omitempty"`
+
+	// Spec of the member Objects
+	// +optional
+	Spec *ObservedObjectTemplateSpec `json:"spec,omitempty"`
+}
+
+// ObservedObjectTemplateSpec represents the parts of the spec of member
+// Objects that can be templated
+type ObservedObjectTemplateSpec struct {
+
+	// ManagementPolicies of the member Objects. Defaults to Observe only.
+	// Note that a member that leaves the collection is deleted, which
+	// deletes its matched object if the policies include Delete.
+	// +optional
+	ManagementPolicies xpv2.ManagementPolicies `json:"managementPolicies,omitempty"`
+
+	// Readiness of the member Objects
+	// +optional
+	Readiness *objectv1alpha1.Readiness `json:"readiness,omitempty"`
+
+	// Watch enables watching the matched object of each member Object. It
+	// is not honored unless the "watches" feature gate is enabled.
+	// +optional
+	Watch bool `json:"watch,omitempty"`
+
+	// ConnectionDetails read from the matched object of each member Object.
+	// +optional
+	ConnectionDetails []ObservedObjectConnectionDetail `json:"connectionDetails,omitempty"`
+
+	// WriteConnectionSecretToReference specifies the connection secret of
+	// each member Object.
+	// +optional
+	WriteConnectionSecretToReference *ObservedObjectSecretReference `json:"writeConnectionSecretToRef,omitempty"`
+}
+
+// ObservedObjectConnectionDetail is a connection detail read from a field of
+// the matched object of a member
+type ObservedObjectConnectionDetail struct {
+
+	// FieldPath of the matched object to read the connection detail from,
+	// e.g. data.password for Secrets.
+	// +kubebuilder:validation:MinLength:=1
+	FieldPath string `json:"fieldPath"`
+
+	// ToConnectionSecretKey is the key of the connection detail in the
+	// connection secret.
+	// +kubebuilder:validation:MinLength:=1
+	ToConnectionSecretKey string `json:"toConnectionSecretKey"`
+}
+
+// ObservedObjectSecretReference is a reference to the connection secret of a
+// member Object
+type ObservedObjectSecretReference struct {
+
+	// NamePattern of the connection secret. The placeholders {name},
+	// {namespace} and {kind} are replaced by the name, namespace and lower
+	// case kind of the matched object, while {objectName} is replaced by the
+	// name of the member Object.
+	// +kubebuilder:default="{objectName}"
+	NamePattern string `json:"namePattern,omitempty"`
 }
 
 // ObservedObjectTemplateMetadata represents objects metadata
@@ -104,6 +295,44 @@ type ObservedObjectTemplateMetadata struct {
 
 	// Annotations of an object
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// DynamicLabels of an object, whose values are read from its matched
+	// object. A label whose value cannot be read, or is not a valid label
+	// value, falls back to the label of the same key in labels, if any.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	DynamicLabels []ObservedObjectTemplateValue `json:"dynamicLabels,omitempty"`
+
+	// DynamicAnnotations of an object, whose values are read from its
+	// matched object. An annotation whose value cannot be read falls back to
+	// the annotation of the same key in annotations, if any.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	DynamicAnnotations []ObservedObjectTemplateValue `json:"dynamicAnnotations,omitempty"`
+}
+
+// ObservedObjectTemplateValue is a label or annotation whose value is read
+// from the matched object of a member
+// +kubebuilder:validation:XValidation:rule="has(self.fieldPath) != has(self.expression)",message="exactly one of fieldPath and expression must be set"
+type ObservedObjectTemplateValue struct {
+
+	// Key of the label or annotation
+	// +kubebuilder:validation:MinLength:=1
+	Key string `json:"key"`
+
+	// FieldPath of the matched object to read the value from, e.g.
+	// metadata.labels[app] or spec.nodeName.
+	// +optional
+	FieldPath string `json:"fieldPath,omitempty"`
+
+	// Expression is a CEL expression evaluated against the matched object,
+	// which is available as the variable 'object', e.g.
+	// object.metadata.ownerReferences[0].name. It must evaluate to a
+	// string, a number or a bool.
+	// +optional
+	Expression string `json:"expression,omitempty"`
 }
 
 // ObservedObjectCollectionStatus represents the observed state of a ObservedObjectCollection
@@ -114,6 +343,43 @@ type ObservedObjectCollectionStatus struct {
 	// and can be used for fetching them.
 	// +optional
 	MembershipLabel map[string]string `json:"membershipLabel,omitempty"`
+
+	// Members of this collection, ordered by the name of their Object. At
+	// most 100 members are listed, see MemberCount for the total.
+	// +optional
+	Members []ObservedObjectMember `json:"members,omitempty"`
+
+	// MemberCount is the number of members of this collection.
+	// +optional
+	MemberCount int64 `json:"memberCount,omitempty"`
+
+	// ReadyMemberCount is the number of members of this collection whose
+	// Object is ready.
+	// +optional
+	ReadyMemberCount int64 `json:"readyMemberCount,omitempty"`
+}
+
+// ObservedObjectMember represents a member of a collection
+type ObservedObjectMember struct {
+
+	// APIVersion of the matched object
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the matched object
+	Kind string `json:"kind"`
+
+	// Namespace of the matched object, if it is namespaced
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the matched object
+	Name string `json:"name"`
+
+	// ObjectName is the name of the Object observing the matched object
+	ObjectName string `json:"objectName"`
+
+	// Ready is true if the Object observing the matched object is ready
+	Ready bool `json:"ready"`
 }
 
 // ObservedObjectReference represents a reference to Object with ObserveOnly management policy
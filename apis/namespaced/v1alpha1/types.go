@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
 
@@ -27,6 +30,17 @@ import (
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv2.ProviderConfigStatus `json:",inline"`
+
+	// PolicyViolations of the resources using this provider config. At most
+	// 100 violations are listed.
+	// +optional
+	PolicyViolations []kconfig.PolicyViolation `json:"policyViolations,omitempty"`
+
+	// PolicyViolationsTruncated is true if more resources violate the
+	// policies than are listed in policyViolations. The PolicyCompliant
+	// condition of each resource reports its violation in any case.
+	// +optional
+	PolicyViolationsTruncated bool `json:"policyViolationsTruncated,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -88,8 +102,54 @@ type ClusterProviderConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   kconfig.ProviderConfigSpec `json:"spec"`
-	Status ProviderConfigStatus       `json:"status,omitempty"`
+	Spec   ClusterProviderConfigSpec `json:"spec"`
+	Status ProviderConfigStatus      `json:"status,omitempty"`
+}
+
+// A ClusterProviderConfigSpec defines the desired state of a
+// ClusterProviderConfig.
+type ClusterProviderConfigSpec struct {
+	kconfig.ProviderConfigSpec `json:",inline"`
+
+	// AllowedNamespaces restricts the namespaces whose resources may use
+	// this ClusterProviderConfig. Resources in any namespace may use it if
+	// unset. A resource that may not use it is not deleted until it is
+	// allowed again, or until Delete is removed from its management
+	// policies, which orphans its remote object.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// AllowedNamespaces selects namespaces by name or by label. A namespace is
+// allowed if it is selected by either.
+type AllowedNamespaces struct {
+	// Names of the allowed namespaces.
+	// +optional
+	// +listType=set
+	Names []string `json:"names,omitempty"`
+
+	// Selector of the allowed namespaces. Crossplane does not grant providers
+	// permission to read namespaces, so the service account of the provider
+	// must be granted permission to get namespaces for the selector to match.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// Allows returns true if the namespace with the supplied name and labels is
+// allowed. The labels are only consulted if the name is not allowed
+// explicitly.
+func (a *AllowedNamespaces) Allows(name string, l labels.Set) (bool, error) {
+	if slices.Contains(a.Names, name) {
+		return true, nil
+	}
+	if a.Selector == nil {
+		return false, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(a.Selector)
+	if err != nil {
+		return false, err
+	}
+	return s.Matches(l), nil
 }
 
 // +kubebuilder:object:root=true
@@ -100,3 +160,43 @@ type ClusterProviderConfigList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []ClusterProviderConfig `json:"items"`
 }
+
+// GetPolicyViolations of this ProviderConfig.
+func (p *ProviderConfig) GetPolicyViolations() []kconfig.PolicyViolation {
+	return p.Status.PolicyViolations
+}
+
+// SetPolicyViolations of this ProviderConfig.
+func (p *ProviderConfig) SetPolicyViolations(v []kconfig.PolicyViolation) {
+	p.Status.PolicyViolations = v
+}
+
+// GetPolicyViolationsTruncated of this ProviderConfig.
+func (p *ProviderConfig) GetPolicyViolationsTruncated() bool {
+	return p.Status.PolicyViolationsTruncated
+}
+
+// SetPolicyViolationsTruncated of this ProviderConfig.
+func (p *ProviderConfig) SetPolicyViolationsTruncated(t bool) {
+	p.Status.PolicyViolationsTruncated = t
+}
+
+// GetPolicyViolations of this ClusterProviderConfig.
+func (p *ClusterProviderConfig) GetPolicyViolations() []kconfig.PolicyViolation {
+	return p.Status.PolicyViolations
+}
+
+// SetPolicyViolations of this ClusterProviderConfig.
+func (p *ClusterProviderConfig) SetPolicyViolations(v []kconfig.PolicyViolation) {
+	p.Status.PolicyViolations = v
+}
+
+// GetPolicyViolationsTruncated of this ClusterProviderConfig.
+func (p *ClusterProviderConfig) GetPolicyViolationsTruncated() bool {
+	return p.Status.PolicyViolationsTruncated
+}
+
+// SetPolicyViolationsTruncated of this ClusterProviderConfig.
+func (p *ClusterProviderConfig) SetPolicyViolationsTruncated(t bool) {
+	p.Status.PolicyViolationsTruncated = t
+}
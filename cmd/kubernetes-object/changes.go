@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// A changeOp is the kind of change made to a field.
+type changeOp string
+
+const (
+	opAdd     changeOp = "+"
+	opRemove  changeOp = "-"
+	opReplace changeOp = "~"
+)
+
+// A change is a change of a single field of a remote object.
+type change struct {
+	op   changeOp
+	path string
+	from any
+	to   any
+}
+
+func (c change) String() string {
+	switch c.op {
+	case opAdd:
+		return fmt.Sprintf("%s %s: %s", c.op, c.path, value(c.to))
+	case opRemove:
+		return fmt.Sprintf("%s %s", c.op, c.path)
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", c.op, c.path, value(c.from), value(c.to))
+	}
+}
+
+func value(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+var plainKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func child(path, key string) string {
+	if !plainKey.MatchString(key) {
+		return path + "[" + strconv.Quote(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// fieldChanges returns the changes that turn the supplied observed fields into
+// the desired ones, sorted by path. Objects are compared field by field, as
+// are lists of equal length; other lists are replaced as a whole.
+func fieldChanges(path string, observed, desired any) []change {
+	if equality.Semantic.DeepEqual(observed, desired) {
+		return nil
+	}
+	switch d := desired.(type) {
+	case map[string]any:
+		o, ok := observed.(map[string]any)
+		if !ok {
+			break
+		}
+		changes := []change{}
+		for _, k := range slices.Sorted(maps.Keys(o)) {
+			if _, ok := d[k]; !ok {
+				changes = append(changes, change{op: opRemove, path: child(path, k), from: o[k]})
+			}
+		}
+		for _, k := range slices.Sorted(maps.Keys(d)) {
+			ov, ok := o[k]
+			if !ok {
+				changes = append(changes, added(child(path, k), d[k])...)
+				continue
+			}
+			changes = append(changes, fieldChanges(child(path, k), ov, d[k])...)
+		}
+		slices.SortStableFunc(changes, func(a, b change) int { return strings.Compare(a.path, b.path) })
+		return changes
+	case []any:
+		o, ok := observed.([]any)
+		if !ok || len(o) != len(d) {
+			break
+		}
+		changes := []change{}
+		for i := range d {
+			changes = append(changes, fieldChanges(fmt.Sprintf("%s[%d]", path, i), o[i], d[i])...)
+		}
+		return changes
+	}
+	if observed == nil {
+		return added(path, desired)
+	}
+	return []change{{op: opReplace, path: path, from: observed, to: desired}}
+}
+
+// added returns the leaf fields of a newly added value as additions.
+func added(path string, v any) []change {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) == 0 {
+		return []change{{op: opAdd, path: path, to: v}}
+	}
+	changes := []change{}
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		changes = append(changes, added(child(path, k), m[k])...)
+	}
+	return changes
+}
+
+// remoteName returns a human readable name of the supplied remote object.
+func remoteName(u *unstructured.Unstructured) string {
+	name := u.GetName()
+	if name == "" {
+		name = u.GetGenerateName() + "<generated>"
+	}
+	return fmt.Sprintf("%s %s %s", u.GetAPIVersion(), u.GetKind(), key(u.GetNamespace(), name))
+}
+
+// printDiff prints what syncing an Object would change in the supplied
+// remote state. It returns true if syncing would change anything.
+func printDiff(w io.Writer, name string, s remoteState) bool {
+	switch {
+	case s.desired == nil:
+		fmt.Fprintf(w, "%s: field managers of the remote object would be upgraded to server side apply\n", name)
+		return true
+	case !s.exists:
+		fmt.Fprintf(w, "%s: would create %s\n", name, remoteName(s.desired))
+		for _, c := range added("", s.desired.Object) {
+			fmt.Fprintf(w, "  %s\n", c)
+		}
+		return true
+	case s.upToDate:
+		fmt.Fprintf(w, "%s: no changes\n", name)
+		return false
+	}
+
+	fmt.Fprintf(w, "%s: would update %s\n", name, remoteName(s.desired))
+	var observed map[string]any
+	if s.observed != nil {
+		observed = s.observed.Object
+	}
+	for _, c := range fieldChanges("", observed, s.desired.Object) {
+		fmt.Fprintf(w, "  %s\n", c)
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFieldChanges(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		observed map[string]any
+		desired  map[string]any
+		want     []string
+	}{
+		"NoChanges": {
+			reason:   "Equal objects should have no changes.",
+			observed: map[string]any{"data": map[string]any{"foo": "bar"}},
+			desired:  map[string]any{"data": map[string]any{"foo": "bar"}},
+		},
+		"Changes": {
+			reason:   "Added, removed and replaced fields should be reported by path.",
+			observed: map[string]any{"data": map[string]any{"foo": "bar", "old": "x"}, "spec": map[string]any{"replicas": int64(1)}},
+			desired:  map[string]any{"data": map[string]any{"foo": "baz", "new": map[string]any{"a": "b"}}, "spec": map[string]any{"replicas": int64(2)}},
+			want: []string{
+				`~ data.foo: "bar" -> "baz"`,
+				`+ data.new.a: "b"`,
+				`- data.old`,
+				`~ spec.replicas: 1 -> 2`,
+			},
+		},
+		"Lists": {
+			reason:   "Lists of equal length should be compared by item, others replaced.",
+			observed: map[string]any{"a": []any{"x", "y"}, "b": []any{"x"}},
+			desired:  map[string]any{"a": []any{"x", "z"}, "b": []any{"x", "y"}},
+			want: []string{
+				`~ a[1]: "y" -> "z"`,
+				`~ b: ["x"] -> ["x","y"]`,
+			},
+		},
+		"SpecialKeys": {
+			reason:   "Keys that are not plain identifiers should be quoted.",
+			observed: map[string]any{"metadata": map[string]any{}},
+			desired:  map[string]any{"metadata": map[string]any{"labels": map[string]any{"app.kubernetes.io/name": "foo"}}},
+			want: []string{
+				`+ metadata.labels["app.kubernetes.io/name"]: "foo"`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, c := range fieldChanges("", tc.observed, tc.desired) {
+				got = append(got, c.String())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nfieldChanges(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+
+	"github.com/alecthomas/kingpin/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	errParseDocument = "cannot parse YAML document"
+	errNoKindOrName  = "document has no apiVersion, kind or metadata.name"
+	errDuplicateName = "Object name %q of %s is already used by %s"
+)
+
+// convertCommand wraps plain Kubernetes resources into Objects.
+type convertCommand struct {
+	cmd *kingpin.CmdClause
+
+	files      []string
+	references bool
+	objects    objectFlags
+}
+
+func newConvertCommand(app *kingpin.Application) *convertCommand {
+	c := &convertCommand{}
+	c.cmd = app.Command("convert", "Convert Kubernetes resources into Objects managing them. Objects are written to stdout.")
+	c.cmd.Arg("file", "Files containing Kubernetes resources, or - for stdin.").Required().StringsVar(&c.files)
+	c.cmd.Flag("references", "Generate references to the Objects of the resources each resource depends on, e.g. the ServiceAccount of a Deployment.").Default("true").BoolVar(&c.references)
+	c.objects.register(c.cmd)
+	return c
+}
+
+// A convertedResource is a resource along with the name of the Object
+// managing it.
+type convertedResource struct {
+	resource *unstructured.Unstructured
+	object   string
+}
+
+// Run runs the convert command.
+func (c *convertCommand) Run(stdin io.Reader, stdout io.Writer) error {
+	name, err := c.objects.namer()
+	if err != nil {
+		return err
+	}
+
+	resources := []*convertedResource{}
+	add := func(u *unstructured.Unstructured) error {
+		if u.GetAPIVersion() == "" || u.GetKind() == "" || u.GetName() == "" {
+			return errors.New(errNoKindOrName)
+		}
+		n, err := name(u)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, &convertedResource{resource: u, object: n})
+		return nil
+	}
+	err = readDocuments(c.files, stdin, func(doc []byte) error {
+		u, err := parseResource(doc)
+		if err != nil {
+			return err
+		}
+		if u.IsList() {
+			return u.EachListItem(func(o runtime.Object) error {
+				return add(o.(*unstructured.Unstructured)) //nolint:forcetypeassert // Items of unstructured lists are unstructured.
+			})
+		}
+		return add(u)
+	})
+	if err != nil {
+		return err
+	}
+
+	index := map[docKey]*convertedResource{}
+	byName := map[string]*convertedResource{}
+	for _, r := range resources {
+		if other, ok := byName[r.object]; ok {
+			return errors.Errorf(errDuplicateName, r.object, resourceName(r.resource), resourceName(other.resource))
+		}
+		byName[r.object] = r
+		for _, k := range keysOf(r.resource) {
+			index[k] = r
+		}
+	}
+
+	for i, r := range resources {
+		var deps []string
+		if c.references {
+			deps = dependencyObjects(r, index)
+		}
+		o, err := c.objects.object(r.resource, r.object, deps)
+		if err != nil {
+			return err
+		}
+		if err := printObject(stdout, o, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseResource(doc []byte) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	return u, errors.Wrap(yaml.Unmarshal(doc, &u.Object), errParseDocument)
+}
+
+// dependencyObjects returns the names of the Objects of the converted
+// resources the supplied one depends on.
+func dependencyObjects(r *convertedResource, index map[docKey]*convertedResource) []string {
+	seen := map[string]bool{r.object: true}
+	deps := []string{}
+	for _, k := range dependencies(r.resource) {
+		d, ok := index[k]
+		if !ok || seen[d.object] {
+			continue
+		}
+		seen[d.object] = true
+		deps = append(deps, d.object)
+	}
+	return deps
+}
+
+// resourceName returns a human readable name of the supplied resource.
+func resourceName(u *unstructured.Unstructured) string {
+	return u.GetKind() + " " + key(u.GetNamespace(), u.GetName())
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const convertInput = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: app
+  namespace: app
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: app
+spec:
+  template:
+    spec:
+      serviceAccountName: app
+      containers:
+      - name: app
+        envFrom:
+        - configMapRef:
+            name: not-converted
+`
+
+func TestConvert(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		c      *convertCommand
+		want   string
+	}{
+		"Namespaced": {
+			reason: "Resources should be wrapped in namespaced Objects referring to the Objects of their dependencies.",
+			c: &convertCommand{
+				references: true,
+				objects: objectFlags{
+					scope:              scopeNamespaced,
+					namespace:          "default",
+					providerConfig:     "default",
+					providerConfigKind: "ClusterProviderConfig",
+					nameTemplate:       defaultNameTemplate,
+					readinessPolicy:    "DeriveFromObject",
+					group:              "app",
+				},
+			},
+			want: `apiVersion: kubernetes.m.crossplane.io/v1alpha1
+kind: Object
+metadata:
+  labels:
+    kubernetes.crossplane.io/group: app
+  name: namespace-app
+  namespace: default
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: v1
+      kind: Namespace
+      metadata:
+        name: app
+  providerConfigRef:
+    kind: ClusterProviderConfig
+    name: default
+  readiness:
+    policy: DeriveFromObject
+---
+apiVersion: kubernetes.m.crossplane.io/v1alpha1
+kind: Object
+metadata:
+  labels:
+    kubernetes.crossplane.io/group: app
+  name: serviceaccount-app-app
+  namespace: default
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: v1
+      kind: ServiceAccount
+      metadata:
+        name: app
+        namespace: app
+  providerConfigRef:
+    kind: ClusterProviderConfig
+    name: default
+  readiness:
+    policy: DeriveFromObject
+  references:
+  - dependsOn:
+      name: namespace-app
+      namespace: default
+---
+apiVersion: kubernetes.m.crossplane.io/v1alpha1
+kind: Object
+metadata:
+  labels:
+    kubernetes.crossplane.io/group: app
+  name: deployment-app-app
+  namespace: default
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: apps/v1
+      kind: Deployment
+      metadata:
+        name: app
+        namespace: app
+      spec:
+        template:
+          spec:
+            containers:
+            - envFrom:
+              - configMapRef:
+                  name: not-converted
+              name: app
+            serviceAccountName: app
+  providerConfigRef:
+    kind: ClusterProviderConfig
+    name: default
+  readiness:
+    policy: DeriveFromObject
+  references:
+  - dependsOn:
+      name: namespace-app
+      namespace: default
+  - dependsOn:
+      name: serviceaccount-app-app
+      namespace: default
+`,
+		},
+		"Cluster": {
+			reason: "Cluster Objects should be named by the template and have no references if disabled.",
+			c: &convertCommand{
+				objects: objectFlags{
+					scope:          scopeCluster,
+					providerConfig: "in-cluster",
+					nameTemplate:   "{{ .Name }}-{{ .Kind | lower }}",
+				},
+			},
+			want: `apiVersion: kubernetes.crossplane.io/v1alpha2
+kind: Object
+metadata:
+  name: app-namespace
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: v1
+      kind: Namespace
+      metadata:
+        name: app
+  providerConfigRef:
+    name: in-cluster
+---
+apiVersion: kubernetes.crossplane.io/v1alpha2
+kind: Object
+metadata:
+  name: app-serviceaccount
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: v1
+      kind: ServiceAccount
+      metadata:
+        name: app
+        namespace: app
+  providerConfigRef:
+    name: in-cluster
+---
+apiVersion: kubernetes.crossplane.io/v1alpha2
+kind: Object
+metadata:
+  name: app-deployment
+spec:
+  forProvider:
+    deletionPropagationPolicy: Background
+    manifest:
+      apiVersion: apps/v1
+      kind: Deployment
+      metadata:
+        name: app
+        namespace: app
+      spec:
+        template:
+          spec:
+            containers:
+            - envFrom:
+              - configMapRef:
+                  name: not-converted
+              name: app
+            serviceAccountName: app
+  providerConfigRef:
+    name: in-cluster
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.c.files = []string{"-"}
+			out := &bytes.Buffer{}
+			if err := tc.c.Run(strings.NewReader(convertInput), out); err != nil {
+				t.Fatalf("Run(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, out.String()); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		doc    string
+		want   []string
+	}{
+		"RoleBinding": {
+			reason: "A RoleBinding should depend on its role and bound service accounts.",
+			doc: `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: rb
+  namespace: ns
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: view
+subjects:
+- kind: ServiceAccount
+  name: sa
+- kind: User
+  name: jane`,
+			want: []string{
+				"CustomResourceDefinition.apiextensions.k8s.io  RoleBinding.rbac.authorization.k8s.io",
+				"Namespace  ns",
+				"ClusterRole.rbac.authorization.k8s.io  view",
+				"ServiceAccount ns sa",
+			},
+		},
+		"CronJob": {
+			reason: "A CronJob should depend on what its pod template refers to.",
+			doc: `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: cj
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          imagePullSecrets:
+          - name: pull
+          volumes:
+          - persistentVolumeClaim:
+              claimName: data
+          initContainers:
+          - env:
+            - valueFrom:
+                secretKeyRef:
+                  name: creds`,
+			want: []string{
+				"CustomResourceDefinition.apiextensions.k8s.io  CronJob.batch",
+				"Secret  pull",
+				"PersistentVolumeClaim  data",
+				"Secret  creds",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			err := eachDocument(strings.NewReader(tc.doc), func(doc []byte) error {
+				u, err := parseResource(doc)
+				for _, k := range dependencies(u) {
+					kind := k.kind.String()
+					if k.kind == kindDefinedByCRD {
+						kind = kindCRD.String()
+					}
+					got = append(got, kind+" "+k.namespace+" "+k.name)
+				}
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndependencies(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
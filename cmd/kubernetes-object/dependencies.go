@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A docKey identifies a Kubernetes resource among the converted documents.
+type docKey struct {
+	kind      schema.GroupKind
+	namespace string
+	name      string
+}
+
+var (
+	kindNamespace          = schema.GroupKind{Kind: "Namespace"}
+	kindServiceAccount     = schema.GroupKind{Kind: "ServiceAccount"}
+	kindConfigMap          = schema.GroupKind{Kind: "ConfigMap"}
+	kindSecret             = schema.GroupKind{Kind: "Secret"}
+	kindPVC                = schema.GroupKind{Kind: "PersistentVolumeClaim"}
+	kindCRD                = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+	kindRoleBinding        = schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding"}
+	kindClusterRoleBinding = schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}
+
+	// kindDefinedByCRD keys a CRD by the kind it defines rather than by its
+	// own name, which is derived from the plural of that kind.
+	kindDefinedByCRD = schema.GroupKind{Group: kindCRD.Group, Kind: "DefinedKind"}
+)
+
+// podSpecPaths are the paths of the pod specs of well-known workload kinds.
+var podSpecPaths = map[schema.GroupKind][]string{
+	{Kind: "Pod"}:                        {"spec"},
+	{Kind: "ReplicationController"}:      {"spec", "template", "spec"},
+	{Group: "apps", Kind: "Deployment"}:  {"spec", "template", "spec"},
+	{Group: "apps", Kind: "StatefulSet"}: {"spec", "template", "spec"},
+	{Group: "apps", Kind: "DaemonSet"}:   {"spec", "template", "spec"},
+	{Group: "apps", Kind: "ReplicaSet"}:  {"spec", "template", "spec"},
+	{Group: "batch", Kind: "Job"}:        {"spec", "template", "spec"},
+	{Group: "batch", Kind: "CronJob"}:    {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// keysOf returns the keys the supplied resource is known by.
+func keysOf(u *unstructured.Unstructured) []docKey {
+	gk := u.GroupVersionKind().GroupKind()
+	keys := []docKey{{kind: gk, namespace: u.GetNamespace(), name: u.GetName()}}
+	if gk == kindCRD {
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "names", "kind")
+		keys = append(keys, docKey{kind: kindDefinedByCRD, name: schema.GroupKind{Group: group, Kind: kind}.String()})
+	}
+	return keys
+}
+
+// dependencies returns the keys of the resources the supplied resource
+// depends on, i.e. that should exist before it is created: its namespace, the
+// CRD of its kind, the resources its pod spec refers to, and the roles and
+// service accounts bound by role bindings.
+func dependencies(u *unstructured.Unstructured) []docKey {
+	ns := u.GetNamespace()
+	gk := u.GroupVersionKind().GroupKind()
+
+	deps := []docKey{{kind: kindDefinedByCRD, name: gk.String()}}
+	if ns != "" {
+		deps = append(deps, docKey{kind: kindNamespace, name: ns})
+	}
+	if path, ok := podSpecPaths[gk]; ok {
+		if spec, ok, _ := unstructured.NestedMap(u.Object, path...); ok {
+			deps = append(deps, podSpecDependencies(spec, ns)...)
+		}
+	}
+	if gk == kindRoleBinding || gk == kindClusterRoleBinding {
+		deps = append(deps, roleBindingDependencies(u.Object, ns)...)
+	}
+	return deps
+}
+
+func podSpecDependencies(spec map[string]any, ns string) []docKey {
+	deps := []docKey{}
+	ref := func(kind schema.GroupKind, m map[string]any, fields ...string) {
+		if name, _, _ := unstructured.NestedString(m, fields...); name != "" {
+			deps = append(deps, docKey{kind: kind, namespace: ns, name: name})
+		}
+	}
+
+	ref(kindServiceAccount, spec, "serviceAccountName")
+	for _, s := range items(spec, "imagePullSecrets") {
+		ref(kindSecret, s, "name")
+	}
+	for _, v := range items(spec, "volumes") {
+		ref(kindConfigMap, v, "configMap", "name")
+		ref(kindSecret, v, "secret", "secretName")
+		ref(kindPVC, v, "persistentVolumeClaim", "claimName")
+		for _, s := range items(v, "projected", "sources") {
+			ref(kindConfigMap, s, "configMap", "name")
+			ref(kindSecret, s, "secret", "name")
+		}
+	}
+	for _, c := range append(items(spec, "initContainers"), items(spec, "containers")...) {
+		for _, e := range items(c, "envFrom") {
+			ref(kindConfigMap, e, "configMapRef", "name")
+			ref(kindSecret, e, "secretRef", "name")
+		}
+		for _, e := range items(c, "env") {
+			ref(kindConfigMap, e, "valueFrom", "configMapKeyRef", "name")
+			ref(kindSecret, e, "valueFrom", "secretKeyRef", "name")
+		}
+	}
+	return deps
+}
+
+func roleBindingDependencies(rb map[string]any, ns string) []docKey {
+	deps := []docKey{}
+	kind, _, _ := unstructured.NestedString(rb, "roleRef", "kind")
+	name, _, _ := unstructured.NestedString(rb, "roleRef", "name")
+	switch kind {
+	case "Role":
+		deps = append(deps, docKey{kind: schema.GroupKind{Group: kindRoleBinding.Group, Kind: kind}, namespace: ns, name: name})
+	case "ClusterRole":
+		deps = append(deps, docKey{kind: schema.GroupKind{Group: kindRoleBinding.Group, Kind: kind}, name: name})
+	}
+	for _, s := range items(rb, "subjects") {
+		if k, _, _ := unstructured.NestedString(s, "kind"); k != kindServiceAccount.Kind {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(s, "name")
+		sns, _, _ := unstructured.NestedString(s, "namespace")
+		if sns == "" {
+			sns = ns
+		}
+		deps = append(deps, docKey{kind: kindServiceAccount, namespace: sns, name: name})
+	}
+	return deps
+}
+
+// items returns the objects in the list at the supplied path.
+func items(m map[string]any, fields ...string) []map[string]any {
+	l, _, _ := unstructured.NestedSlice(m, fields...)
+	objs := make([]map[string]any, 0, len(l))
+	for _, i := range l {
+		if o, ok := i.(map[string]any); ok {
+			objs = append(objs, o)
+		}
+	}
+	return objs
+}
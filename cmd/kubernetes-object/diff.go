@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Exit codes of the diff command, following kubectl diff.
+const (
+	exitNoChanges = 0
+	exitChanges   = 1
+	exitError     = 2
+)
+
+// diffCommand prints what syncing Objects would change in the clusters they
+// are synced to, without changing anything.
+type diffCommand struct {
+	cmd *kingpin.CmdClause
+
+	files  []string
+	all    bool
+	target targetFlags
+}
+
+func newDiffCommand(app *kingpin.Application) *diffCommand {
+	c := &diffCommand{}
+	c.cmd = app.Command("diff", "Show what syncing Objects would change in the clusters they are synced to. Exits with 0 if nothing would change, 1 if something would and 2 on errors.")
+	c.cmd.Arg("file", "Files containing Objects, or - for stdin. Other kinds of resources are skipped.").StringsVar(&c.files)
+	c.cmd.Flag("all", "Diff all Objects on the control plane.").BoolVar(&c.all)
+	c.target.register(c.cmd)
+	return c
+}
+
+// Run runs the diff command and returns its exit code.
+func (c *diffCommand) Run(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer) int {
+	conn := newConnector(&c.target)
+
+	var objs []resource.Managed
+	var err error
+	switch {
+	case c.all && len(c.files) > 0:
+		err = fmt.Errorf("--all cannot be combined with files")
+	case c.all:
+		objs, err = conn.listObjects(ctx)
+	case len(c.files) > 0:
+		objs, err = readObjects(c.files, stdin)
+	default:
+		err = fmt.Errorf("either files or --all must be specified")
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %s\n", err)
+		return exitError
+	}
+
+	code := exitNoChanges
+	for _, o := range objs {
+		name := objectName(o)
+		s, err := conn.observe(ctx, o)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: error: %s\n", name, err)
+			code = exitError
+			continue
+		}
+		if printDiff(stdout, name, s) && code == exitNoChanges {
+			code = exitChanges
+		}
+	}
+	return code
+}
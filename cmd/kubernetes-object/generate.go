@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/alecthomas/kingpin/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	clusterv1alpha2 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+)
+
+const (
+	scopeCluster    = "cluster"
+	scopeNamespaced = "namespaced"
+
+	// groupLabel is the label that groups the Objects generated together.
+	groupLabel = "kubernetes.crossplane.io/group"
+
+	defaultNameTemplate = "{{ .Kind | lower }}-{{ with .Namespace }}{{ . }}-{{ end }}{{ .Name }}"
+
+	errParseNameTemplate = "cannot parse name template"
+	errExecNameTemplate  = "cannot execute name template"
+	errInvalidName       = "invalid Object name %q for %s: %s"
+	errMarshalObject     = "cannot marshal Object"
+)
+
+var notInName = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// objectFlags are the flags of commands that generate Objects managing
+// Kubernetes resources.
+type objectFlags struct {
+	scope              string
+	namespace          string
+	providerConfig     string
+	providerConfigKind string
+	readinessPolicy    string
+	nameTemplate       string
+	group              string
+
+	// managementPolicies of the generated Objects. The API default is used
+	// if empty.
+	managementPolicies xpv2.ManagementPolicies
+}
+
+func (f *objectFlags) register(cmd *kingpin.CmdClause) {
+	cmd.Flag("scope", "Scope of the generated Objects, either cluster or namespaced.").Default(scopeNamespaced).EnumVar(&f.scope, scopeCluster, scopeNamespaced)
+	cmd.Flag("namespace", "Namespace of the generated namespaced Objects.").Short('n').Default("default").StringVar(&f.namespace)
+	cmd.Flag("provider-config", "Name of the ProviderConfig the generated Objects refer to.").Default(defaultProviderConfig).StringVar(&f.providerConfig)
+	cmd.Flag("provider-config-kind", "Kind of the ProviderConfig namespaced Objects refer to.").Default(apisnamespacedv1alpha1.ClusterProviderConfigKind).EnumVar(&f.providerConfigKind, apisnamespacedv1alpha1.ClusterProviderConfigKind, apisnamespacedv1alpha1.ProviderConfigKind)
+	cmd.Flag("readiness-policy", "Readiness policy of the generated Objects. Defaults to the API default.").EnumVar(&f.readinessPolicy,
+		string(namespacedv1alpha1.ReadinessPolicySuccessfulCreate), string(namespacedv1alpha1.ReadinessPolicyDeriveFromObject), string(namespacedv1alpha1.ReadinessPolicyAllTrue))
+	cmd.Flag("name-template", "Go template of the names of the generated Objects. It is passed the .Group, .Version, .Kind, .Namespace and .Name of each resource.").Default(defaultNameTemplate).StringVar(&f.nameTemplate)
+	cmd.Flag("group", "Group the generated Objects by labeling them with "+groupLabel+"=<group>.").StringVar(&f.group)
+}
+
+// namer returns a function that names the Object of a resource according to
+// the name template.
+func (f *objectFlags) namer() (func(*unstructured.Unstructured) (string, error), error) {
+	t, err := template.New("name").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(f.nameTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseNameTemplate)
+	}
+	return func(u *unstructured.Unstructured) (string, error) {
+		gvk := u.GroupVersionKind()
+		buf := &bytes.Buffer{}
+		err := t.Execute(buf, map[string]string{
+			"Group":     gvk.Group,
+			"Version":   gvk.Version,
+			"Kind":      gvk.Kind,
+			"Namespace": u.GetNamespace(),
+			"Name":      u.GetName(),
+		})
+		if err != nil {
+			return "", errors.Wrap(err, errExecNameTemplate)
+		}
+		n := strings.Trim(notInName.ReplaceAllString(strings.ToLower(buf.String()), "-"), "-.")
+		if errs := validation.IsDNS1123Subdomain(n); len(errs) > 0 {
+			return "", errors.Errorf(errInvalidName, n, resourceName(u), strings.Join(errs, ", "))
+		}
+		return n, nil
+	}, nil
+}
+
+// object returns the Object with the supplied name managing the supplied
+// resource and depending on the supplied Objects.
+func (f *objectFlags) object(u *unstructured.Unstructured, name string, deps []string) (client.Object, error) {
+	manifest, err := u.MarshalJSON()
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalObject)
+	}
+	meta := metav1.ObjectMeta{Name: name}
+	if f.group != "" {
+		meta.Labels = map[string]string{groupLabel: f.group}
+	}
+
+	var o client.Object
+	switch f.scope {
+	case scopeCluster:
+		obj := &clusterv1alpha2.Object{
+			TypeMeta:   metav1.TypeMeta{APIVersion: clusterv1alpha2.SchemeGroupVersion.String(), Kind: clusterv1alpha2.ObjectKind},
+			ObjectMeta: meta,
+			Spec: clusterv1alpha2.ObjectSpec{
+				ClusterManagedResourceSpec: xpv2.ClusterManagedResourceSpec{
+					ProviderConfigReference: &xpv2.Reference{Name: f.providerConfig},
+					ManagementPolicies:      f.managementPolicies,
+				},
+				ForProvider: clusterv1alpha2.ObjectParameters{
+					Manifest:                  runtime.RawExtension{Raw: manifest},
+					DeletionPropagationPolicy: metav1.DeletePropagationBackground,
+				},
+				Readiness: clusterv1alpha2.Readiness{Policy: clusterv1alpha2.ReadinessPolicy(f.readinessPolicy)},
+			},
+		}
+		for _, d := range deps {
+			obj.Spec.References = append(obj.Spec.References, clusterv1alpha2.Reference{DependsOn: &clusterv1alpha2.DependsOn{Name: d}})
+		}
+		o = obj
+	default:
+		meta.Namespace = f.namespace
+		obj := &namespacedv1alpha1.Object{
+			TypeMeta:   metav1.TypeMeta{APIVersion: namespacedv1alpha1.SchemeGroupVersion.String(), Kind: namespacedv1alpha1.ObjectKind},
+			ObjectMeta: meta,
+			Spec: namespacedv1alpha1.ObjectSpec{
+				ManagedResourceSpec: xpv2.ManagedResourceSpec{
+					ProviderConfigReference: &xpv2.ProviderConfigReference{Kind: f.providerConfigKind, Name: f.providerConfig},
+					ManagementPolicies:      f.managementPolicies,
+				},
+				ForProvider: namespacedv1alpha1.ObjectParameters{
+					Manifest:                  runtime.RawExtension{Raw: manifest},
+					DeletionPropagationPolicy: metav1.DeletePropagationBackground,
+				},
+				Readiness: namespacedv1alpha1.Readiness{Policy: namespacedv1alpha1.ReadinessPolicy(f.readinessPolicy)},
+			},
+		}
+		for _, d := range deps {
+			obj.Spec.References = append(obj.Spec.References, namespacedv1alpha1.Reference{DependsOn: &namespacedv1alpha1.DependsOn{Name: d, Namespace: f.namespace}})
+		}
+		o = obj
+	}
+
+	return o, nil
+}
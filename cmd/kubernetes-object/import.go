@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/alecthomas/kingpin/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	apisclusterv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/extractor"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	policiesObserve = "observe"
+	policiesFull    = "full"
+
+	// importFieldManager is the field manager the fields of imported resources
+	// are merged into before they are extracted.
+	importFieldManager = "kubernetes-object-import"
+
+	errParseAPIVersion = "cannot parse apiVersion"
+	errListResources   = "cannot list resources"
+	errNewDiscovery    = "cannot create discovery client"
+	errNewExtractor    = "cannot create managed fields extractor"
+	errMergeFields     = "cannot merge managed fields of %s"
+	errExtractFields   = "cannot extract managed fields of %s"
+)
+
+// importCommand generates Objects managing live resources.
+type importCommand struct {
+	cmd *kingpin.CmdClause
+
+	apiVersion         string
+	kind               string
+	fromNamespace      string
+	selector           string
+	fieldManagers      []string
+	managementPolicies string
+	objects            objectFlags
+	target             targetFlags
+}
+
+func newImportCommand(app *kingpin.Application) *importCommand {
+	c := &importCommand{}
+	c.cmd = app.Command("import", "Generate Objects managing the live resources of the cluster a ProviderConfig connects to. Objects are written to stdout.")
+	c.cmd.Flag("api-version", "API version of the resources to import.").Required().StringVar(&c.apiVersion)
+	c.cmd.Flag("kind", "Kind of the resources to import.").Required().StringVar(&c.kind)
+	c.cmd.Flag("from-namespace", "Namespace of the resources to import. Defaults to all namespaces.").StringVar(&c.fromNamespace)
+	c.cmd.Flag("selector", "Label selector of the resources to import.").Short('l').StringVar(&c.selector)
+	c.cmd.Flag("field-manager", "Keep only the fields managed by this field manager. Defaults to the fields managed by any field manager, which drops defaulted values.").StringsVar(&c.fieldManagers)
+	c.cmd.Flag("management-policies", "Generate Objects that only observe their resources, or that fully manage them. Fully managed Objects apply their manifests, so combine full with --field-manager to only manage the fields of the original field manager.").Default(policiesObserve).EnumVar(&c.managementPolicies, policiesObserve, policiesFull)
+	c.cmd.Flag("kubeconfig", "Kubeconfig of the cluster to import from, instead of the one the ProviderConfig connects to.").StringVar(&c.target.kubeconfig)
+	c.cmd.Flag("control-plane-kubeconfig", "Kubeconfig of the control plane. Defaults to the in-cluster config, $KUBECONFIG or ~/.kube/config.").StringVar(&c.target.controlPlaneKubeconfig)
+	c.objects.register(c.cmd)
+	return c
+}
+
+// Run runs the import command.
+func (c *importCommand) Run(ctx context.Context, stdout io.Writer) error {
+	name, err := c.objects.namer()
+	if err != nil {
+		return err
+	}
+	if c.managementPolicies == policiesObserve {
+		c.objects.managementPolicies = xpv2.ManagementPolicies{xpv2.ManagementActionObserve}
+	}
+
+	conn := newConnector(&c.target)
+	t, err := c.connect(ctx, conn)
+	if err != nil {
+		return err
+	}
+	resources, err := c.list(ctx, t.kube)
+	if err != nil {
+		return err
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(t.rest)
+	if err != nil {
+		return errors.Wrap(err, errNewDiscovery)
+	}
+	parserCache, err := conn.parserCaches.LoadOrNewCacheForProviderConfig(t.pc)
+	if err != nil {
+		return errors.Wrap(err, errLoadParserCache)
+	}
+	ex, err := extractor.NewCachingUnstructuredExtractor(ctx, dc, parserCache)
+	if err != nil {
+		return errors.Wrap(err, errNewExtractor)
+	}
+
+	names := map[string]string{}
+	for i := range resources {
+		u := &resources[i]
+		m, err := importedManifest(u, ex, c.fieldManagers)
+		if err != nil {
+			return err
+		}
+		n, err := name(m)
+		if err != nil {
+			return err
+		}
+		if other, ok := names[n]; ok {
+			return errors.Errorf(errDuplicateName, n, resourceName(m), other)
+		}
+		names[n] = resourceName(m)
+		o, err := c.objects.object(m, n, nil)
+		if err != nil {
+			return err
+		}
+		if err := printObject(stdout, o, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connect returns the cluster to import from, i.e. the one the ProviderConfig
+// of the generated Objects connects to.
+func (c *importCommand) connect(ctx context.Context, conn *connector) (*target, error) {
+	if c.target.kubeconfig != "" {
+		return conn.fixedTarget()
+	}
+	local, err := conn.controlPlane()
+	if err != nil {
+		return nil, err
+	}
+
+	var pc resource.ProviderConfig
+	var spec *kconfig.ProviderConfigSpec
+	switch {
+	case c.objects.scope == scopeCluster:
+		cpc := &apisclusterv1alpha1.ProviderConfig{}
+		err = local.Get(ctx, types.NamespacedName{Name: c.objects.providerConfig}, cpc)
+		pc, spec = cpc, &cpc.Spec
+	case c.objects.providerConfigKind == apisnamespacedv1alpha1.ProviderConfigKind:
+		npc := &apisnamespacedv1alpha1.ProviderConfig{}
+		err = local.Get(ctx, types.NamespacedName{Namespace: c.objects.namespace, Name: c.objects.providerConfig}, npc)
+		pc, spec = npc, &npc.Spec
+	default:
+		cpc := &apisnamespacedv1alpha1.ClusterProviderConfig{}
+		err = local.Get(ctx, types.NamespacedName{Name: c.objects.providerConfig}, cpc)
+		pc, spec = cpc, &cpc.Spec.ProviderConfigSpec
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	return conn.targetForProviderConfig(ctx, pc, spec)
+}
+
+// list returns the resources to import.
+func (c *importCommand) list(ctx context.Context, kube client.Client) ([]unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(c.apiVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseAPIVersion)
+	}
+	sel, err := labels.Parse(c.selector)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseSelector)
+	}
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gv.WithKind(c.kind + "List"))
+	if err := kube.List(ctx, l, client.InNamespace(c.fromNamespace), client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		return nil, errors.Wrap(err, errListResources)
+	}
+	return l.Items, nil
+}
+
+// importedManifest returns the manifest of an Object managing the supplied
+// live resource. It consists of the fields managed by the supplied field
+// managers, or by any field manager if none are supplied. Values defaulted by
+// the API server are not managed by any field manager, and thus dropped.
+// Metadata populated by the API server is dropped with CleanManifest, as it
+// is from the manifests of the members of observed object collections.
+func importedManifest(u *unstructured.Unstructured, ex applymetav1.UnstructuredExtractor, fieldManagers []string) (*unstructured.Unstructured, error) {
+	e, err := ssa.MergedFieldsEntry(u.GetManagedFields(), u.GetAPIVersion(), fieldManagers, importFieldManager)
+	if err != nil {
+		return nil, errors.Wrapf(err, errMergeFields, resourceName(u))
+	}
+
+	m := u
+	if e != nil {
+		src := u.DeepCopy()
+		src.SetManagedFields([]metav1.ManagedFieldsEntry{*e})
+		if m, err = ex.Extract(src, importFieldManager); err != nil {
+			return nil, errors.Wrapf(err, errExtractFields, resourceName(u))
+		}
+	}
+	return pcontroller.CleanManifest(m), nil
+}
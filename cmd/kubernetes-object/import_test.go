@@ -0,0 +1,107 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type extractorFn func(object *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error)
+
+func (fn extractorFn) Extract(object *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error) {
+	return fn(object, fieldManager)
+}
+
+func (fn extractorFn) ExtractStatus(object *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error) {
+	return fn(object, fieldManager)
+}
+
+func TestImportedManifest(t *testing.T) {
+	live := func(managedFields ...metav1.ManagedFieldsEntry) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":              "cm",
+				"generateName":      "c",
+				"namespace":         "default",
+				"uid":               "8c5c1b5e",
+				"finalizers":        []any{"example.org/finalizer"},
+				"resourceVersion":   "42",
+				"creationTimestamp": "2026-01-01T00:00:00Z",
+				"annotations": map[string]any{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				},
+			},
+			"data": map[string]any{"foo": "bar"},
+		}}
+		u.SetManagedFields(managedFields)
+		return u
+	}
+	entry := func(manager string, op metav1.ManagedFieldsOperationType) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  op,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+		}
+	}
+	extracted := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "cm", "namespace": "default"},
+		"data":       map[string]any{"foo": "bar"},
+	}}
+	merged := entry(importFieldManager, metav1.ManagedFieldsOperationApply)
+
+	cases := map[string]struct {
+		reason        string
+		u             *unstructured.Unstructured
+		fieldManagers []string
+		want          *unstructured.Unstructured
+		wantExtract   bool
+	}{
+		"Extract": {
+			reason:      "Fields of all field managers should be merged into one applied entry and extracted.",
+			u:           live(entry("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate)),
+			want:        extracted,
+			wantExtract: true,
+		},
+		"UnknownFieldManager": {
+			reason:        "Server populated fields should be dropped if the field manager manages no fields.",
+			u:             live(entry("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate)),
+			fieldManagers: []string{"helm"},
+			want: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": "cm", "namespace": "default"},
+				"data":       map[string]any{"foo": "bar"},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			ex := extractorFn(func(object *unstructured.Unstructured, fieldManager string) (*unstructured.Unstructured, error) {
+				called = true
+				if diff := cmp.Diff([]metav1.ManagedFieldsEntry{merged}, object.GetManagedFields()); diff != "" {
+					t.Errorf("Extract(...): -want managed fields, +got:\n%s", diff)
+				}
+				return extracted.DeepCopy(), nil
+			})
+			got, err := importedManifest(tc.u, ex, tc.fieldManagers)
+			if err != nil {
+				t.Fatalf("importedManifest(...): unexpected error: %v", err)
+			}
+			if called != tc.wantExtract {
+				t.Errorf("\n%s\nimportedManifest(...): extracted: want %t, got %t", tc.reason, tc.wantExtract, called)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nimportedManifest(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
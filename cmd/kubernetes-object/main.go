@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubernetes-object inspects and manages provider-kubernetes Objects
+// from outside the provider.
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+)
+
+func main() {
+	app := kingpin.New(filepath.Base(os.Args[0]), "Inspect and manage provider-kubernetes Objects.").DefaultEnvars()
+	diff := newDiffCommand(app)
+	convert := newConvertCommand(app)
+	migrate := newMigrateCommand(app)
+	imprt := newImportCommand(app)
+
+	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
+	case diff.cmd.FullCommand():
+		os.Exit(diff.Run(context.Background(), os.Stdin, os.Stdout, os.Stderr))
+	case convert.cmd.FullCommand():
+		kingpin.FatalIfError(convert.Run(os.Stdin, os.Stdout), "cannot convert resources")
+	case migrate.cmd.FullCommand():
+		kingpin.FatalIfError(migrate.Run(context.Background(), os.Stdout, os.Stderr), "cannot migrate Objects")
+	case imprt.cmd.FullCommand():
+		kingpin.FatalIfError(imprt.Run(context.Background(), os.Stdout), "cannot import resources")
+	}
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/alecthomas/kingpin/v2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	clusterv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha1"
+	clusterv1alpha2 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	namespacedobject "github.com/crossplane-contrib/provider-kubernetes/internal/controller/namespaced/object"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	errNothingToMigrate  = "either Object names, --all or --selector must be specified"
+	errParseSelector     = "cannot parse label selector"
+	errGetObject         = "cannot get Object"
+	errBeingDeleted      = "Object is being deleted"
+	errPauseObject       = "cannot pause Object"
+	errParseManifest     = "cannot parse manifest"
+	errGetRemoteObject   = "cannot get remote object"
+	errTransferOwnership = "cannot transfer field ownership of remote object"
+	errNamespaceChanged  = "the namespace policy of the provider config moves the remote object from namespace %q to %q"
+	errRemoteReplaced    = "remote object %s has UID %q rather than UID %q of the remote object of the Object"
+	errCreateObject      = "cannot create namespaced Object"
+	errRetireObject      = "cannot orphan Object"
+	errDeleteObject      = "cannot delete Object"
+	errMigrate           = "cannot migrate %d Objects"
+)
+
+// migrateCommand migrates cluster-scoped Objects to namespaced Objects
+// without touching their remote objects.
+type migrateCommand struct {
+	cmd *kingpin.CmdClause
+
+	names              []string
+	all                bool
+	selector           string
+	namespace          string
+	providerConfigKind string
+	providerConfigs    map[string]string
+	nameTemplate       string
+	dryRun             bool
+	target             targetFlags
+}
+
+func newMigrateCommand(app *kingpin.Application) *migrateCommand {
+	c := &migrateCommand{}
+	c.cmd = app.Command("migrate", "Migrate cluster-scoped Objects to namespaced Objects, keeping their remote objects and their field ownership.")
+	c.cmd.Arg("name", "Names of the cluster-scoped Objects to migrate.").StringsVar(&c.names)
+	c.cmd.Flag("all", "Migrate all cluster-scoped Objects.").BoolVar(&c.all)
+	c.cmd.Flag("selector", "Migrate the cluster-scoped Objects matching this label selector.").Short('l').StringVar(&c.selector)
+	c.cmd.Flag("namespace", "Namespace of the namespaced Objects.").Short('n').Required().StringVar(&c.namespace)
+	c.cmd.Flag("provider-config-kind", "Kind of the ProviderConfig the namespaced Objects refer to.").Default(apisnamespacedv1alpha1.ClusterProviderConfigKind).EnumVar(&c.providerConfigKind, apisnamespacedv1alpha1.ClusterProviderConfigKind, apisnamespacedv1alpha1.ProviderConfigKind)
+	c.cmd.Flag("provider-config", "Maps the name of a cluster-scoped ProviderConfig to the name of the ProviderConfig of the namespaced Objects, as OLD=NEW. Unmapped names are kept.").StringMapVar(&c.providerConfigs)
+	c.cmd.Flag("name-template", "Go template of the names of the namespaced Objects. It is passed the .Name of the cluster-scoped Object.").Default("{{ .Name }}").StringVar(&c.nameTemplate)
+	c.cmd.Flag("dry-run", "Print the namespaced Objects without migrating anything.").BoolVar(&c.dryRun)
+	c.cmd.Flag("control-plane-kubeconfig", "Kubeconfig of the control plane. Defaults to the in-cluster config, $KUBECONFIG or ~/.kube/config.").StringVar(&c.target.controlPlaneKubeconfig)
+	return c
+}
+
+// Run runs the migrate command.
+func (c *migrateCommand) Run(ctx context.Context, stdout, stderr io.Writer) error {
+	name, err := c.namer()
+	if err != nil {
+		return err
+	}
+	conn := newConnector(&c.target)
+	local, err := conn.controlPlane()
+	if err != nil {
+		return err
+	}
+	objs, err := c.objects(ctx, local)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, o := range objs {
+		n, err := c.namespacedObject(o, name)
+		if err == nil && c.dryRun {
+			err = printObject(stdout, n, i)
+		}
+		if err == nil && !c.dryRun {
+			err = c.migrate(ctx, conn, local, o, n)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: error: %s\n", objectName(o), err)
+			failed++
+			continue
+		}
+		if !c.dryRun {
+			fmt.Fprintf(stdout, "%s: migrated to %s\n", objectName(o), objectName(n))
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf(errMigrate, failed)
+	}
+	return nil
+}
+
+// objects returns the cluster-scoped Objects to migrate.
+func (c *migrateCommand) objects(ctx context.Context, local client.Client) ([]*clusterv1alpha2.Object, error) {
+	objs := []*clusterv1alpha2.Object{}
+	if len(c.names) > 0 {
+		for _, n := range c.names {
+			o := &clusterv1alpha2.Object{}
+			if err := local.Get(ctx, types.NamespacedName{Name: n}, o); err != nil {
+				return nil, errors.Wrapf(err, "%s: %s", errGetObject, n)
+			}
+			objs = append(objs, o)
+		}
+		return objs, nil
+	}
+	if !c.all && c.selector == "" {
+		return nil, errors.New(errNothingToMigrate)
+	}
+	sel, err := labels.Parse(c.selector)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseSelector)
+	}
+	l := &clusterv1alpha2.ObjectList{}
+	if err := local.List(ctx, l, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		return nil, errors.Wrap(err, errListObjects)
+	}
+	for i := range l.Items {
+		objs = append(objs, &l.Items[i])
+	}
+	return objs, nil
+}
+
+// migrate migrates the supplied cluster-scoped Object to the supplied
+// namespaced one. The cluster-scoped Object is paused first, so that it does
+// not race the namespaced one. Then the fields it applied to its remote object
+// are transferred to the field manager of the namespaced Object, which is
+// created next. Finally, the cluster-scoped Object is deleted without deleting
+// its remote object. Migrating an Object again resumes where it stopped.
+func (c *migrateCommand) migrate(ctx context.Context, conn *connector, local client.Client, o *clusterv1alpha2.Object, n *namespacedv1alpha1.Object) error {
+	if meta.WasDeleted(o) {
+		return errors.New(errBeingDeleted)
+	}
+
+	if !meta.IsPaused(o) {
+		p := fmt.Appendf(nil, `{"metadata":{"annotations":{%q:"true"}}}`, meta.AnnotationKeyReconciliationPaused)
+		if err := local.Patch(ctx, o, client.RawPatch(types.MergePatchType, p)); err != nil {
+			return errors.Wrap(err, errPauseObject)
+		}
+	}
+
+	if err := transferOwnership(ctx, conn, o, n); err != nil {
+		return err
+	}
+
+	if err := local.Create(ctx, n); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+		return errors.Wrap(err, errCreateObject)
+	}
+
+	p := fmt.Appendf(nil, `{"metadata":{"annotations":{%q:null}},"spec":{"deletionPolicy":%q,"managementPolicies":[%q]}}`,
+		meta.AnnotationKeyReconciliationPaused, xpv2.DeletionOrphan, xpv2.ManagementActionObserve)
+	if err := local.Patch(ctx, o, client.RawPatch(types.MergePatchType, p)); err != nil {
+		return errors.Wrap(err, errRetireObject)
+	}
+	return errors.Wrap(resource.IgnoreNotFound(local.Delete(ctx, o)), errDeleteObject)
+}
+
+// transferOwnership transfers the fields of the remote object applied by the
+// cluster-scoped Object to the field manager of the namespaced one. It refuses
+// to do so if the namespaced Object would manage a different remote object,
+// because the provider config it refers to targets another namespace or
+// cluster.
+func transferOwnership(ctx context.Context, conn *connector, o *clusterv1alpha2.Object, n *namespacedv1alpha1.Object) error {
+	t, err := conn.targetFor(ctx, n)
+	if err != nil {
+		return err
+	}
+	remote, err := targetRemote(n, t.spec, t.kube.IsObjectNamespaced)
+	if err != nil {
+		return err
+	}
+	err = t.kube.Get(ctx, types.NamespacedName{Namespace: remote.GetNamespace(), Name: remote.GetName()}, remote)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetRemoteObject)
+	}
+	if uid := o.Status.AtProvider.UID; uid != "" && uid != remote.GetUID() {
+		return errors.Errorf(errRemoteReplaced, key(remote.GetNamespace(), remote.GetName()), remote.GetUID(), uid)
+	}
+	p, err := ssa.TransferFieldOwnershipPatch(remote, ssa.FieldOwner(o.GetName()), ssa.FieldOwner(n.GetName()))
+	if err != nil {
+		return errors.Wrap(err, errTransferOwnership)
+	}
+	if p == nil {
+		return nil
+	}
+	return errors.Wrap(t.kube.Patch(ctx, remote, client.RawPatch(types.JSONPatchType, p)), errTransferOwnership)
+}
+
+// targetRemote returns the remote object the supplied namespaced Object
+// manages according to the policies of the supplied provider config spec, and
+// rejects it if that is not the remote object of the cluster-scoped Object it
+// was migrated from, i.e. the one named by its unmodified manifest.
+func targetRemote(n *namespacedv1alpha1.Object, spec *kconfig.ProviderConfigSpec, isNamespaced func(runtime.Object) (bool, error)) (*unstructured.Unstructured, error) {
+	remote := &unstructured.Unstructured{}
+	if err := json.Unmarshal(n.Spec.ForProvider.Manifest.Raw, remote); err != nil {
+		return nil, errors.Wrap(err, errParseManifest)
+	}
+	if spec == nil {
+		return remote, nil
+	}
+	namespace := remote.GetNamespace()
+	if err := namespacedobject.ApplyNamespacePolicy(spec.NamespacePolicy, n.GetNamespace(), remote, isNamespaced); err != nil {
+		return nil, err
+	}
+	if remote.GetNamespace() != namespace {
+		return nil, errors.Errorf(errNamespaceChanged, namespace, remote.GetNamespace())
+	}
+	return remote, nil
+}
+
+// namespacedObject returns the namespaced counterpart of the supplied
+// cluster-scoped Object.
+func (c *migrateCommand) namespacedObject(o *clusterv1alpha2.Object, name func(string) (string, error)) (*namespacedv1alpha1.Object, error) {
+	n, err := name(o.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// The name of the remote object defaults to the name of the Object, which
+	// may change, and is overridden by an external name that differs from it.
+	manifest := &unstructured.Unstructured{}
+	if err := json.Unmarshal(o.Spec.ForProvider.Manifest.Raw, manifest); err != nil {
+		return nil, errors.Wrap(err, errParseManifest)
+	}
+	if en := meta.GetExternalName(o); en != "" && en != o.GetName() {
+		manifest.SetName(en)
+	}
+	if manifest.GetName() == "" {
+		manifest.SetName(o.GetName())
+	}
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseManifest)
+	}
+
+	annotations := maps.Clone(o.GetAnnotations())
+	delete(annotations, meta.AnnotationKeyReconciliationPaused)
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	delete(annotations, meta.AnnotationKeyExternalName)
+	no := &namespacedv1alpha1.Object{
+		TypeMeta: metav1.TypeMeta{APIVersion: namespacedv1alpha1.SchemeGroupVersion.String(), Kind: namespacedv1alpha1.ObjectKind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        n,
+			Namespace:   c.namespace,
+			Labels:      o.GetLabels(),
+			Annotations: annotations,
+		},
+		Spec: namespacedv1alpha1.ObjectSpec{
+			ManagedResourceSpec: xpv2.ManagedResourceSpec{
+				ManagementPolicies: managementPolicies(o),
+			},
+			ForProvider: namespacedv1alpha1.ObjectParameters{
+				Manifest:                  runtime.RawExtension{Raw: raw},
+				DeletionPropagationPolicy: o.Spec.ForProvider.DeletionPropagationPolicy,
+				LateInitializePaths:       o.Spec.ForProvider.LateInitializePaths,
+			},
+			Readiness: namespacedv1alpha1.Readiness{
+				Policy:   namespacedv1alpha1.ReadinessPolicy(o.Spec.Readiness.Policy),
+				CelQuery: o.Spec.Readiness.CelQuery,
+			},
+			Watch:  o.Spec.Watch,
+			DryRun: o.Spec.DryRun,
+			Replacement: namespacedv1alpha1.Replacement{
+				Policy:             namespacedv1alpha1.ReplacementPolicy(o.Spec.Replacement.Policy),
+				UpdatePrecondition: o.Spec.Replacement.UpdatePrecondition,
+			},
+			ExternalDeletionPolicy: namespacedv1alpha1.ExternalDeletionPolicy(o.Spec.ExternalDeletionPolicy),
+		},
+	}
+
+	pc := defaultProviderConfig
+	if ref := o.GetProviderConfigReference(); ref != nil {
+		pc = ref.Name
+	}
+	if mapped, ok := c.providerConfigs[pc]; ok {
+		pc = mapped
+	}
+	no.Spec.ProviderConfigReference = &xpv2.ProviderConfigReference{Kind: c.providerConfigKind, Name: pc}
+	if ref := o.GetWriteConnectionSecretToReference(); ref != nil {
+		no.Spec.WriteConnectionSecretToReference = &xpv2.LocalSecretReference{Name: ref.Name}
+	}
+	for _, cd := range o.Spec.ConnectionDetails {
+		no.Spec.ConnectionDetails = append(no.Spec.ConnectionDetails, namespacedv1alpha1.ConnectionDetail{ObjectReference: cd.ObjectReference, ToConnectionSecretKey: cd.ToConnectionSecretKey})
+	}
+	for _, r := range o.Spec.References {
+		nr := namespacedv1alpha1.Reference{ToFieldPath: r.ToFieldPath}
+		if r.DependsOn != nil {
+			d, err := c.dependsOn(*r.DependsOn, name)
+			if err != nil {
+				return nil, err
+			}
+			nr.DependsOn = &d
+		}
+		if r.PatchesFrom != nil {
+			d, err := c.dependsOn(r.PatchesFrom.DependsOn, name)
+			if err != nil {
+				return nil, err
+			}
+			nr.PatchesFrom = &namespacedv1alpha1.PatchesFrom{DependsOn: d, FieldPath: r.PatchesFrom.FieldPath}
+		}
+		no.Spec.References = append(no.Spec.References, nr)
+	}
+	return no, nil
+}
+
+// dependsOn returns the namespaced counterpart of a reference of a
+// cluster-scoped Object. References to cluster-scoped Objects become
+// references to the namespaced Objects they are migrated to.
+func (c *migrateCommand) dependsOn(d clusterv1alpha2.DependsOn, name func(string) (string, error)) (namespacedv1alpha1.DependsOn, error) {
+	isObject := (d.Kind == "" || d.Kind == clusterv1alpha2.ObjectKind) &&
+		(d.APIVersion == "" || d.APIVersion == clusterv1alpha1.SchemeGroupVersion.String() || d.APIVersion == clusterv1alpha2.SchemeGroupVersion.String())
+	if !isObject {
+		return namespacedv1alpha1.DependsOn{APIVersion: d.APIVersion, Kind: d.Kind, Name: d.Name, Namespace: d.Namespace}, nil
+	}
+	n, err := name(d.Name)
+	return namespacedv1alpha1.DependsOn{Name: n, Namespace: c.namespace}, err
+}
+
+// managementPolicies returns the management policies of the namespaced
+// counterpart of the supplied Object. Namespaced Objects have no deletion
+// policy, so an orphaning Object must not have the Delete policy instead.
+func managementPolicies(o *clusterv1alpha2.Object) xpv2.ManagementPolicies {
+	p := slices.Clone(o.GetManagementPolicies())
+	if o.GetDeletionPolicy() != xpv2.DeletionOrphan {
+		return p
+	}
+	if len(p) == 0 || slices.Contains(p, xpv2.ManagementActionAll) {
+		return xpv2.ManagementPolicies{xpv2.ManagementActionObserve, xpv2.ManagementActionCreate, xpv2.ManagementActionUpdate, xpv2.ManagementActionLateInitialize}
+	}
+	return slices.DeleteFunc(p, func(a xpv2.ManagementAction) bool { return a == xpv2.ManagementActionDelete })
+}
+
+// namer returns a function that names the namespaced counterpart of a
+// cluster-scoped Object according to the name template.
+func (c *migrateCommand) namer() (func(string) (string, error), error) {
+	t, err := template.New("name").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(c.nameTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseNameTemplate)
+	}
+	return func(name string) (string, error) {
+		buf := &bytes.Buffer{}
+		if err := t.Execute(buf, map[string]string{"Name": name}); err != nil {
+			return "", errors.Wrap(err, errExecNameTemplate)
+		}
+		n := buf.String()
+		if errs := validation.IsDNS1123Subdomain(n); len(errs) > 0 {
+			return "", errors.Errorf(errInvalidName, n, "Object "+name, strings.Join(errs, ", "))
+		}
+		return n, nil
+	}, nil
+}
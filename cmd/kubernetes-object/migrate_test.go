@@ -0,0 +1,239 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	clusterv1alpha2 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+func TestNamespacedObject(t *testing.T) {
+	c := &migrateCommand{
+		namespace:          "objects",
+		providerConfigKind: "ClusterProviderConfig",
+		providerConfigs:    map[string]string{"old": "new"},
+		nameTemplate:       "{{ .Name }}-ns",
+	}
+	name, err := c.namer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := &clusterv1alpha2.Object{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "foo",
+			Labels:      map[string]string{"app": "foo"},
+			Annotations: map[string]string{"crossplane.io/paused": "true", "crossplane.io/external-name": "foo", "note": "kept"},
+		},
+		Spec: clusterv1alpha2.ObjectSpec{
+			ClusterManagedResourceSpec: xpv2.ClusterManagedResourceSpec{
+				ProviderConfigReference:          &xpv2.Reference{Name: "old"},
+				WriteConnectionSecretToReference: &xpv2.SecretReference{Name: "conn", Namespace: "elsewhere"},
+				DeletionPolicy:                   xpv2.DeletionOrphan,
+			},
+			ForProvider: clusterv1alpha2.ObjectParameters{
+				Manifest:                  runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Namespace"}`)},
+				DeletionPropagationPolicy: metav1.DeletePropagationForeground,
+			},
+			References: []clusterv1alpha2.Reference{
+				{DependsOn: &clusterv1alpha2.DependsOn{Name: "bar"}},
+				{
+					PatchesFrom: &clusterv1alpha2.PatchesFrom{
+						DependsOn: clusterv1alpha2.DependsOn{APIVersion: "v1", Kind: "ConfigMap", Name: "cm", Namespace: "default"},
+						FieldPath: ptr.To("data.foo"),
+					},
+					ToFieldPath: ptr.To("metadata.labels.foo"),
+				},
+			},
+			Readiness: clusterv1alpha2.Readiness{Policy: clusterv1alpha2.ReadinessPolicyDeriveFromObject},
+		},
+	}
+
+	want := &namespacedv1alpha1.Object{
+		TypeMeta: metav1.TypeMeta{APIVersion: "kubernetes.m.crossplane.io/v1alpha1", Kind: "Object"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "foo-ns",
+			Namespace:   "objects",
+			Labels:      map[string]string{"app": "foo"},
+			Annotations: map[string]string{"note": "kept"},
+		},
+		Spec: namespacedv1alpha1.ObjectSpec{
+			ManagedResourceSpec: xpv2.ManagedResourceSpec{
+				ProviderConfigReference:          &xpv2.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "new"},
+				WriteConnectionSecretToReference: &xpv2.LocalSecretReference{Name: "conn"},
+				ManagementPolicies:               xpv2.ManagementPolicies{"Observe", "Create", "Update", "LateInitialize"},
+			},
+			ForProvider: namespacedv1alpha1.ObjectParameters{
+				Manifest:                  runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"foo"}}`)},
+				DeletionPropagationPolicy: metav1.DeletePropagationForeground,
+			},
+			References: []namespacedv1alpha1.Reference{
+				{DependsOn: &namespacedv1alpha1.DependsOn{Name: "bar-ns", Namespace: "objects"}},
+				{
+					PatchesFrom: &namespacedv1alpha1.PatchesFrom{
+						DependsOn: namespacedv1alpha1.DependsOn{APIVersion: "v1", Kind: "ConfigMap", Name: "cm", Namespace: "default"},
+						FieldPath: ptr.To("data.foo"),
+					},
+					ToFieldPath: ptr.To("metadata.labels.foo"),
+				},
+			},
+			Readiness: namespacedv1alpha1.Readiness{Policy: namespacedv1alpha1.ReadinessPolicyDeriveFromObject},
+		},
+	}
+
+	got, err := c.namespacedObject(o, name)
+	if err != nil {
+		t.Fatalf("namespacedObject(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("namespacedObject(...): -want, +got:\n%s", diff)
+	}
+	if _, ok := o.GetAnnotations()["crossplane.io/paused"]; !ok {
+		t.Errorf("namespacedObject(...): must not modify the cluster-scoped Object")
+	}
+}
+
+func TestNamespacedObjectExternalName(t *testing.T) {
+	c := &migrateCommand{nameTemplate: "{{ .Name }}"}
+	name, err := c.namer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := &clusterv1alpha2.Object{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "foo",
+			Annotations: map[string]string{"crossplane.io/external-name": "adopted"},
+		},
+		Spec: clusterv1alpha2.ObjectSpec{
+			ForProvider: clusterv1alpha2.ObjectParameters{
+				Manifest: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"bar","namespace":"default"}}`)},
+			},
+		},
+	}
+
+	got, err := c.namespacedObject(o, name)
+	if err != nil {
+		t.Fatalf("namespacedObject(...): unexpected error: %v", err)
+	}
+	want := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"adopted","namespace":"default"}}`
+	if diff := cmp.Diff(want, string(got.Spec.ForProvider.Manifest.Raw)); diff != "" {
+		t.Errorf("namespacedObject(...): the external name should be pinned as the manifest name: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{}, got.GetAnnotations()); diff != "" {
+		t.Errorf("namespacedObject(...): the external name should be dropped: -want, +got:\n%s", diff)
+	}
+}
+
+func TestManagementPolicies(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		policies xpv2.ManagementPolicies
+		deletion xpv2.DeletionPolicy
+		want     xpv2.ManagementPolicies
+	}{
+		"Delete": {
+			reason:   "Policies of deleting Objects should be kept.",
+			policies: xpv2.ManagementPolicies{"*"},
+			deletion: xpv2.DeletionDelete,
+			want:     xpv2.ManagementPolicies{"*"},
+		},
+		"OrphanAll": {
+			reason:   "Orphaning Objects should get all policies but Delete.",
+			policies: xpv2.ManagementPolicies{"*"},
+			deletion: xpv2.DeletionOrphan,
+			want:     xpv2.ManagementPolicies{"Observe", "Create", "Update", "LateInitialize"},
+		},
+		"OrphanExplicit": {
+			reason:   "The Delete policy of orphaning Objects should be dropped.",
+			policies: xpv2.ManagementPolicies{"Observe", "Delete"},
+			deletion: xpv2.DeletionOrphan,
+			want:     xpv2.ManagementPolicies{"Observe"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &clusterv1alpha2.Object{}
+			o.SetManagementPolicies(tc.policies)
+			o.SetDeletionPolicy(tc.deletion)
+			if diff := cmp.Diff(tc.want, managementPolicies(o)); diff != "" {
+				t.Errorf("\n%s\nmanagementPolicies(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTargetRemote(t *testing.T) {
+	namespaced := func(runtime.Object) (bool, error) { return true, nil }
+	cases := map[string]struct {
+		reason    string
+		manifest  string
+		policy    *kconfig.NamespacePolicy
+		want      string
+		wantError bool
+	}{
+		"NoPolicy": {
+			reason:   "Without a namespace policy the remote object should be named by the manifest.",
+			manifest: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"default"}}`,
+			want:     "default/foo",
+		},
+		"Unchanged": {
+			reason:   "A namespace policy that keeps the namespace of the manifest should be accepted.",
+			manifest: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"default"}}`,
+			policy:   &kconfig.NamespacePolicy{InheritObjectNamespace: true, AllowedNamespaces: []string{"default"}},
+			want:     "default/foo",
+		},
+		"Inherited": {
+			reason:    "A namespace policy that moves the remote object into the namespace of the Object should be refused.",
+			manifest:  `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}`,
+			policy:    &kconfig.NamespacePolicy{InheritObjectNamespace: true},
+			wantError: true,
+		},
+		"Mapped": {
+			reason:    "A namespace policy that maps the namespace of the manifest should be refused.",
+			manifest:  `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"team"}}`,
+			policy:    &kconfig.NamespacePolicy{Mappings: []kconfig.NamespaceMapping{{From: "team", To: "team-prod"}}},
+			wantError: true,
+		},
+		"NotAllowed": {
+			reason:    "A namespace the namespace policy does not allow should be refused.",
+			manifest:  `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"default"}}`,
+			policy:    &kconfig.NamespacePolicy{AllowedNamespaces: []string{"team"}},
+			wantError: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			n := &namespacedv1alpha1.Object{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "team"},
+				Spec: namespacedv1alpha1.ObjectSpec{
+					ForProvider: namespacedv1alpha1.ObjectParameters{
+						Manifest: runtime.RawExtension{Raw: []byte(tc.manifest)},
+					},
+				},
+			}
+			var spec *kconfig.ProviderConfigSpec
+			if tc.policy != nil {
+				spec = &kconfig.ProviderConfigSpec{NamespacePolicy: tc.policy}
+			}
+			got, err := targetRemote(n, spec, namespaced)
+			if diff := cmp.Diff(tc.wantError, err != nil); diff != "" {
+				t.Fatalf("\n%s\ntargetRemote(...): -want error, +got error: %v", tc.reason, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, key(got.GetNamespace(), got.GetName())); diff != "" {
+				t.Errorf("\n%s\ntargetRemote(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
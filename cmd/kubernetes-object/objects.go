@@ -0,0 +1,415 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/alecthomas/kingpin/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	apiscluster "github.com/crossplane-contrib/provider-kubernetes/apis/cluster"
+	clusterv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha1"
+	clusterv1alpha2 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	apisclusterv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	apisnamespaced "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced"
+	namespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	clusterobject "github.com/crossplane-contrib/provider-kubernetes/internal/controller/cluster/object"
+	namespacedobject "github.com/crossplane-contrib/provider-kubernetes/internal/controller/namespaced/object"
+	kubeclient "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/extractor"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/state"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	errOpenFile               = "cannot open file"
+	errReadDocument           = "cannot read YAML document"
+	errDecodeDocument         = "cannot decode YAML document"
+	errConvertObject          = "cannot convert v1alpha1 Object to v1alpha2"
+	errLoadControlPlaneConfig = "cannot load control plane kubeconfig"
+	errLoadTargetConfig       = "cannot load target cluster kubeconfig"
+	errNewClient              = "cannot create kube client"
+	errListObjects            = "cannot list Objects"
+	errGetProviderConfig      = "cannot get provider config"
+	errBuildKube              = "cannot build kube client for provider config"
+	errLoadParserCache        = "cannot load parser cache"
+	errUnsupportedObject      = "unsupported Object type %T"
+)
+
+// defaultProviderConfig is the ProviderConfig Objects refer to by default.
+const defaultProviderConfig = "default"
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(apiscluster.AddToScheme(scheme))
+	utilruntime.Must(apisnamespaced.AddToScheme(scheme))
+}
+
+// readDocuments calls fn with each YAML document in the supplied files. A
+// file named "-" is read from stdin.
+func readDocuments(files []string, stdin io.Reader, fn func(doc []byte) error) error {
+	for _, f := range files {
+		if err := readFile(f, stdin, fn); err != nil {
+			return errors.Wrapf(err, "%s", f)
+		}
+	}
+	return nil
+}
+
+// readFile calls fn with each YAML document in the supplied file, which is
+// closed before readFile returns.
+func readFile(f string, stdin io.Reader, fn func(doc []byte) error) error {
+	if f == "-" {
+		return eachDocument(stdin, fn)
+	}
+	fh, err := os.Open(f) //nolint:gosec // Reading user supplied files is the point.
+	if err != nil {
+		return errors.Wrap(err, errOpenFile)
+	}
+	defer fh.Close() //nolint:errcheck // Only read from.
+	return eachDocument(fh, fn)
+}
+
+func eachDocument(r io.Reader, fn func(doc []byte) error) error {
+	yr := kyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := yr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errReadDocument)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+}
+
+// readObjects returns the Objects in the supplied files. Documents of other
+// kinds are skipped, and deprecated cluster-scoped v1alpha1 Objects are
+// converted to v1alpha2.
+func readObjects(files []string, stdin io.Reader) ([]resource.Managed, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	objs := []resource.Managed{}
+	err := readDocuments(files, stdin, func(doc []byte) error {
+		o, _, err := decoder.Decode(doc, nil, nil)
+		if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errDecodeDocument)
+		}
+		switch o := o.(type) {
+		case *clusterv1alpha1.Object:
+			dst := &clusterv1alpha2.Object{}
+			if err := o.ConvertTo(dst); err != nil {
+				return errors.Wrap(err, errConvertObject)
+			}
+			objs = append(objs, dst)
+		case *clusterv1alpha2.Object:
+			objs = append(objs, o)
+		case *namespacedv1alpha1.Object:
+			objs = append(objs, o)
+		}
+		return nil
+	})
+	return objs, err
+}
+
+// objectName returns a human readable name of the supplied Object.
+func objectName(o resource.Managed) string {
+	gk := o.GetObjectKind().GroupVersionKind().GroupKind()
+	switch o.(type) {
+	case *clusterv1alpha2.Object:
+		gk = clusterv1alpha2.ObjectGroupVersionKind.GroupKind()
+	case *namespacedv1alpha1.Object:
+		gk = namespacedv1alpha1.ObjectGroupVersionKind.GroupKind()
+	}
+	return gk.String() + " " + key(o.GetNamespace(), o.GetName())
+}
+
+// key returns the name of an object, prefixed with its namespace if any.
+func key(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// targetFlags are the flags of commands that connect to the cluster an Object
+// is synced to.
+type targetFlags struct {
+	kubeconfig             string
+	providerConfig         string
+	controlPlaneKubeconfig string
+	serverSideApply        bool
+	legacyCSAFieldManagers []string
+}
+
+func (f *targetFlags) register(cmd *kingpin.CmdClause) {
+	cmd.Flag("kubeconfig", "Kubeconfig of the cluster Objects are synced to. By default, the ProviderConfig of each Object is resolved on the control plane.").StringVar(&f.kubeconfig)
+	cmd.Flag("provider-config", "Name of the ProviderConfig on the control plane to use instead of the one each Object refers to.").StringVar(&f.providerConfig)
+	cmd.Flag("control-plane-kubeconfig", "Kubeconfig of the control plane. Defaults to the in-cluster config, $KUBECONFIG or ~/.kube/config.").StringVar(&f.controlPlaneKubeconfig)
+	cmd.Flag("server-side-apply", "Compute the desired state as the provider does with server side apply enabled.").Default("true").BoolVar(&f.serverSideApply)
+	cmd.Flag("legacy-csa-field-managers", "Additional legacy client-side apply field manager names the provider upgrades to its SSA field manager.").StringsVar(&f.legacyCSAFieldManagers)
+}
+
+// A target is the cluster an Object is synced to.
+type target struct {
+	kube client.Client
+	rest *rest.Config
+	pc   resource.ProviderConfig
+	// spec is the spec of pc, whose policies apply to manifests. It is nil
+	// for a target given by a kubeconfig.
+	spec *kconfig.ProviderConfigSpec
+}
+
+// A connector connects to the control plane and to the clusters Objects are
+// synced to, and observes Objects there.
+type connector struct {
+	flags *targetFlags
+
+	local    client.Client
+	localErr error
+	fixed    *target
+
+	parserCaches *extractor.GVKParserCacheManager
+	stateCaches  *state.DesiredStateCacheManager
+	legacy       sets.Set[string]
+}
+
+func newConnector(f *targetFlags) *connector {
+	return &connector{
+		flags:        f,
+		parserCaches: extractor.NewGVKParserCacheManager(),
+		stateCaches:  state.NewDesiredStateCacheManager(),
+		legacy:       sets.New[string](append(f.legacyCSAFieldManagers, pcontroller.DefaultCSAFieldManager())...),
+	}
+}
+
+// controlPlane returns a client of the control plane, connecting on first
+// use.
+func (c *connector) controlPlane() (client.Client, error) {
+	if c.local != nil || c.localErr != nil {
+		return c.local, c.localErr
+	}
+	rc, err := config.GetConfig()
+	if c.flags.controlPlaneKubeconfig != "" {
+		rc, err = clientcmd.BuildConfigFromFlags("", c.flags.controlPlaneKubeconfig)
+	}
+	if err != nil {
+		c.localErr = errors.Wrap(err, errLoadControlPlaneConfig)
+		return nil, c.localErr
+	}
+	c.local, err = client.New(rc, client.Options{Scheme: scheme})
+	c.localErr = errors.Wrap(err, errNewClient)
+	return c.local, c.localErr
+}
+
+// listObjects returns all Objects on the control plane. Kinds whose CRD is
+// not installed are skipped.
+func (c *connector) listObjects(ctx context.Context) ([]resource.Managed, error) {
+	local, err := c.controlPlane()
+	if err != nil {
+		return nil, err
+	}
+	objs := []resource.Managed{}
+	cl := &clusterv1alpha2.ObjectList{}
+	if err := local.List(ctx, cl); resource.Ignore(meta.IsNoMatchError, err) != nil {
+		return nil, errors.Wrap(err, errListObjects)
+	}
+	for i := range cl.Items {
+		objs = append(objs, &cl.Items[i])
+	}
+	nl := &namespacedv1alpha1.ObjectList{}
+	if err := local.List(ctx, nl); resource.Ignore(meta.IsNoMatchError, err) != nil {
+		return nil, errors.Wrap(err, errListObjects)
+	}
+	for i := range nl.Items {
+		objs = append(objs, &nl.Items[i])
+	}
+	return objs, nil
+}
+
+// targetFor returns the cluster the supplied Object is synced to.
+func (c *connector) targetFor(ctx context.Context, o resource.Managed) (*target, error) {
+	if c.flags.kubeconfig != "" {
+		return c.fixedTarget()
+	}
+	local, err := c.controlPlane()
+	if err != nil {
+		return nil, err
+	}
+
+	var pc resource.ProviderConfig
+	var spec *kconfig.ProviderConfigSpec
+	switch o := o.(type) {
+	case *clusterv1alpha2.Object:
+		// Objects read from files are not defaulted by the API server.
+		if o.Spec.ProviderConfigReference == nil {
+			o.Spec.ProviderConfigReference = &xpv2.Reference{Name: defaultProviderConfig}
+		}
+		if c.flags.providerConfig != "" {
+			o.Spec.ProviderConfigReference.Name = c.flags.providerConfig
+		}
+		cpc := &apisclusterv1alpha1.ProviderConfig{}
+		if err := local.Get(ctx, types.NamespacedName{Name: o.GetProviderConfigReference().Name}, cpc); err != nil {
+			return nil, errors.Wrap(err, errGetProviderConfig)
+		}
+		pc, spec = cpc, &cpc.Spec
+	case *namespacedv1alpha1.Object:
+		if o.Spec.ProviderConfigReference == nil {
+			o.Spec.ProviderConfigReference = &xpv2.ProviderConfigReference{Kind: apisnamespacedv1alpha1.ClusterProviderConfigKind, Name: defaultProviderConfig}
+		}
+		if c.flags.providerConfig != "" {
+			o.Spec.ProviderConfigReference.Name = c.flags.providerConfig
+		}
+		if pc, spec, err = namespacedobject.ResolveProviderConfig(ctx, local, local, o); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf(errUnsupportedObject, o)
+	}
+
+	return c.targetForProviderConfig(ctx, pc, spec)
+}
+
+// targetForProviderConfig returns the cluster the supplied ProviderConfig
+// connects to.
+func (c *connector) targetForProviderConfig(ctx context.Context, pc resource.ProviderConfig, spec *kconfig.ProviderConfigSpec) (*target, error) {
+	local, err := c.controlPlane()
+	if err != nil {
+		return nil, err
+	}
+	k, rc, err := kubeclient.NewIdentityAwareBuilder(local).KubeForProviderConfig(ctx, *spec)
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildKube)
+	}
+	return &target{kube: k, rest: rc, pc: pc, spec: spec}, nil
+}
+
+func (c *connector) fixedTarget() (*target, error) {
+	if c.fixed != nil {
+		return c.fixed, nil
+	}
+	rc, err := clientcmd.BuildConfigFromFlags("", c.flags.kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadTargetConfig)
+	}
+	k, err := client.New(rc, client.Options{})
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	// All Objects share the one target cluster, and hence its parser cache.
+	c.fixed = &target{kube: k, rest: rc, pc: &apisclusterv1alpha1.ProviderConfig{}}
+	return c.fixed, nil
+}
+
+// A remoteState is the state of the remote object of an Object.
+type remoteState struct {
+	exists   bool
+	upToDate bool
+	observed *unstructured.Unstructured
+	desired  *unstructured.Unstructured
+}
+
+// observe returns the state of the remote object of the supplied Object,
+// computed exactly as the provider would. Nothing is persisted.
+func (c *connector) observe(ctx context.Context, o resource.Managed) (remoteState, error) {
+	t, err := c.targetFor(ctx, o)
+	if err != nil {
+		return remoteState{}, err
+	}
+	// The control plane is only needed to resolve references, which
+	// ObserveRemoteState reports if it is unavailable.
+	local, _ := c.controlPlane()
+	// A target given by a kubeconfig has no policies.
+	spec := t.spec
+	if spec == nil {
+		spec = &kconfig.ProviderConfigSpec{}
+	}
+
+	switch o := o.(type) {
+	case *clusterv1alpha2.Object:
+		rs, err := clusterobject.NewRemoteObserver(local, c.flags.serverSideApply, c.parserCaches, c.stateCaches, c.legacy).ObserveRemoteState(ctx, o, t.pc, spec, t.kube, t.rest)
+		return remoteState{exists: rs.Exists, upToDate: rs.UpToDate(), observed: rs.Observed, desired: rs.Desired}, err
+	case *namespacedv1alpha1.Object:
+		rs, err := namespacedobject.NewRemoteObserver(local, c.flags.serverSideApply, c.parserCaches, c.stateCaches, c.legacy).ObserveRemoteState(ctx, o, t.pc, spec, t.kube, t.rest)
+		return remoteState{exists: rs.Exists, upToDate: rs.UpToDate(), observed: rs.Observed, desired: rs.Desired}, err
+	default:
+		return remoteState{}, errors.Errorf(errUnsupportedObject, o)
+	}
+}
+
+// printObject prints the YAML of the supplied Object as the i-th document of
+// a stream.
+func printObject(w io.Writer, o client.Object, i int) error {
+	j, err := json.Marshal(o)
+	if err != nil {
+		return errors.Wrap(err, errMarshalObject)
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(j); err != nil {
+		return errors.Wrap(err, errMarshalObject)
+	}
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	for _, f := range []string{"readiness", "replacement"} {
+		if m, _, _ := unstructured.NestedMap(u.Object, "spec", f); len(m) == 0 {
+			unstructured.RemoveNestedField(u.Object, "spec", f)
+		}
+	}
+	y, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return errors.Wrap(err, errMarshalObject)
+	}
+	if i > 0 {
+		fmt.Fprintln(w, "---")
+	}
+	_, err = w.Write(y)
+	return err
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadObjects(t *testing.T) {
+	const docs = `
+apiVersion: kubernetes.crossplane.io/v1alpha1
+kind: Object
+metadata:
+  name: legacy
+spec:
+  providerConfigRef:
+    name: default
+  forProvider:
+    manifest:
+      apiVersion: v1
+      kind: Namespace
+      metadata:
+        name: legacy
+---
+# A document with comments only.
+---
+apiVersion: kubernetes.crossplane.io/v1alpha1
+kind: ProviderConfig
+metadata:
+  name: default
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unrelated
+---
+apiVersion: kubernetes.m.crossplane.io/v1alpha1
+kind: Object
+metadata:
+  name: namespaced
+  namespace: default
+spec:
+  forProvider:
+    manifest:
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: namespaced
+`
+	objs, err := readObjects([]string{"-"}, strings.NewReader(docs))
+	if err != nil {
+		t.Fatalf("readObjects(...): unexpected error: %v", err)
+	}
+	got := make([]string, 0, len(objs))
+	for _, o := range objs {
+		got = append(got, objectName(o))
+	}
+	want := []string{
+		"Object.kubernetes.crossplane.io legacy",
+		"Object.kubernetes.m.crossplane.io default/namespaced",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("readObjects(...): -want, +got:\n%s", diff)
+	}
+}
@@ -99,6 +99,7 @@ func main() {
 		enableWatches            = app.Flag("enable-watches", "Enable support for watching resources.").Default("false").Envar("ENABLE_WATCHES").Bool()
 		enableServerSideApply    = app.Flag("enable-server-side-apply", "Enable server side apply to sync object manifests to k8s API.").Default("true").Envar("ENABLE_SERVER_SIDE_APPLY").Bool()
 		enableChangeLogs         = app.Flag("enable-changelogs", "Enable support for capturing change logs during reconciliation.").Default("false").Envar("ENABLE_CHANGE_LOGS").Bool()
+		enableManifestValidation = app.Flag("enable-manifest-validation", "Enable validation of Object manifests against the OpenAPI schemas of the target cluster. Requires server side apply.").Default("false").Envar("ENABLE_MANIFEST_VALIDATION").Bool()
 		removeManagedFields      = app.Flag("remove-managed-fields", "Remove metadata.managedFields from status.atProvider.manifest.").Default("false").Envar("REMOVE_MANAGED_FIELDS").Bool()
 		// Additional legacy field managers to upgrade to Server-side apply field manager
 		legacyCSAFieldManagers = app.Flag("legacy-csa-field-managers", "Additional legacy client-side apply Kubernetes field manager names for upgrading to SSA field manager").Default().Strings()
@@ -176,6 +177,7 @@ func main() {
 
 	metrics.Registry.MustRegister(mm)
 	metrics.Registry.MustRegister(sm)
+	metrics.Registry.MustRegister(pcontroller.ExternalDeletions)
 
 	mo := controller.MetricOptions{
 		PollStateMetricInterval: *pollStateMetricInterval,
@@ -218,6 +220,11 @@ func main() {
 		log.Info("Beta feature enabled", "flag", features.EnableBetaServerSideApply)
 	}
 
+	if *enableManifestValidation {
+		o.Features.Enable(features.EnableAlphaManifestValidation)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManifestValidation)
+	}
+
 	if *enableChangeLogs {
 		o.Features.Enable(feature.EnableAlphaChangeLogs)
 		log.Info("Alpha feature enabled", "flag", feature.EnableAlphaChangeLogs)
@@ -238,6 +245,8 @@ func main() {
 	// which version we use here. Leaving it as v1alpha1 as it will be easy to
 	// notice and remove when we drop support for v1alpha1.
 	kingpin.FatalIfError(ctrl.NewWebhookManagedBy(mgr, &objectv1alpha1cluster.Object{}).Complete(), "Cannot create Object webhook") //nolint:staticcheck // registering conversion webhook for deprecated api
+	kingpin.FatalIfError(controllerCluster.SetupWebhooks(mgr), "Cannot setup cluster-scoped validating webhooks")
+	kingpin.FatalIfError(controllerNamespaced.SetupWebhooks(mgr), "Cannot setup namespaced validating webhooks")
 	precheckCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	canSafeStart, err := canWatchCRD(precheckCtx, mgr)
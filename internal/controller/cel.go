@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	errCreateCELEnvironment = "cannot create CEL environment"
+	errCELNotBool           = "expression must evaluate to a bool"
+	errCELNotScalar         = "expression must evaluate to a string, a number or a bool"
+	errCELNotString         = "cannot convert value of type %s to a string"
+	errCELCreateProgram     = "cannot create program from CEL expression"
+	errCELEstimateCost      = "cannot estimate the cost of CEL expression"
+	errCELCostExceeded      = "estimated worst case cost %d of the expression exceeds the limit of %d"
+)
+
+// The cost of CEL expressions is limited as for the CEL expressions of the
+// API server.
+const (
+	// celCostLimit is the maximum cost of evaluating an expression once.
+	celCostLimit = 1000000
+	// celInterruptCheckFrequency is the number of comprehension iterations
+	// after which an evaluation checks whether it was interrupted.
+	celInterruptCheckFrequency = 100
+	// celStaticCostLimit is the maximum estimated worst case cost of an
+	// expression, checked when it is admitted.
+	celStaticCostLimit = 100000000
+	// celMaxObjectSize is the maximum size, in bytes, of the object and of
+	// any value in it when estimating the worst case cost of an expression.
+	// It is the maximum size of a request to the API server.
+	celMaxObjectSize = 3 * 1024 * 1024
+)
+
+// A CELPredicate is a compiled CEL expression that evaluates to a bool.
+type CELPredicate struct {
+	program cel.Program
+}
+
+// CompileCELPredicate compiles the supplied CEL expression, which must
+// evaluate to a bool. The object it is evaluated against is available to the
+// expression as the variable 'object'.
+func CompileCELPredicate(expr string) (*CELPredicate, error) {
+	program, err := compileCEL(expr, errCELNotBool, func(t *cel.Type) bool {
+		return reflect.DeepEqual(t, cel.BoolType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CELPredicate{program: program}, nil
+}
+
+// Eval returns true if the predicate holds for the supplied object.
+func (p *CELPredicate) Eval(object map[string]any) (bool, error) {
+	val, _, err := p.program.Eval(map[string]any{
+		"object": object,
+	})
+	if err != nil {
+		return false, err
+	}
+	return val == celtypes.True, nil
+}
+
+// A CELExpression is a compiled CEL expression whose value is converted to a
+// string.
+type CELExpression struct {
+	program cel.Program
+}
+
+// CompileCELExpression compiles the supplied CEL expression, which must
+// evaluate to a string, a number or a bool. The object it is evaluated
+// against is available to the expression as the variable 'object'.
+func CompileCELExpression(expr string) (*CELExpression, error) {
+	program, err := compileCEL(expr, errCELNotScalar, func(t *cel.Type) bool {
+		for _, s := range []*cel.Type{cel.StringType, cel.IntType, cel.UintType, cel.DoubleType, cel.BoolType, cel.DynType} {
+			if reflect.DeepEqual(t, s) {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CELExpression{program: program}, nil
+}
+
+// Eval returns the value of the expression for the supplied object.
+func (e *CELExpression) Eval(object map[string]any) (string, error) {
+	val, _, err := e.program.Eval(map[string]any{
+		"object": object,
+	})
+	if err != nil {
+		return "", err
+	}
+	s := val.ConvertToType(celtypes.StringType)
+	if celtypes.IsError(s) {
+		return "", errors.Errorf(errCELNotString, val.Type().TypeName())
+	}
+	return s.Value().(string), nil
+}
+
+// compileCEL compiles the supplied expression into a program, provided the
+// output type of the expression is accepted. The cost of each evaluation of
+// the program is limited.
+func compileCEL(expr, errWrongType string, accept func(*cel.Type) bool) (cel.Program, error) {
+	env, ast, err := parseCEL(expr)
+	if err != nil {
+		return nil, err
+	}
+	if !accept(ast.OutputType()) {
+		return nil, errors.New(errWrongType)
+	}
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit), cel.InterruptCheckFrequency(celInterruptCheckFrequency))
+	if err != nil {
+		return nil, errors.Wrap(err, errCELCreateProgram)
+	}
+	return program, nil
+}
+
+// checkCELCost returns an error if the estimated worst case cost of the
+// supplied expression exceeds the static cost limit. The worst case assumes
+// that the object and every value in it are as large as the largest object
+// the API server accepts.
+func checkCELCost(expr string) error {
+	env, ast, err := parseCEL(expr)
+	if err != nil {
+		return err
+	}
+	est, err := env.EstimateCost(ast, maxSizeEstimator{})
+	if err != nil {
+		return errors.Wrap(err, errCELEstimateCost)
+	}
+	if est.Max > celStaticCostLimit {
+		return errors.Errorf(errCELCostExceeded, est.Max, celStaticCostLimit)
+	}
+	return nil
+}
+
+// parseCEL compiles the supplied expression into a checked AST. The object
+// it is evaluated against is available to the expression as the variable
+// 'object'.
+func parseCEL(expr string) (*cel.Env, *cel.Ast, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.AnyType),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errCreateCELEnvironment)
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, nil, iss.Err()
+	}
+	return env, ast, nil
+}
+
+// maxSizeEstimator estimates every value to be at most as large as the
+// largest object the API server accepts, and every call to have the default
+// cost.
+type maxSizeEstimator struct{}
+
+func (maxSizeEstimator) EstimateSize(checker.AstNode) *checker.SizeEstimate {
+	return &checker.SizeEstimate{Min: 0, Max: celMaxObjectSize}
+}
+
+func (maxSizeEstimator) EstimateCallCost(string, string, *checker.AstNode, []checker.AstNode) *checker.CallEstimate {
+	return nil
+}
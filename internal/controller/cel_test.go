@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCELExpression(t *testing.T) {
+	object := map[string]any{
+		"metadata": map[string]any{
+			"name":   "foo",
+			"labels": map[string]any{"app": "bar"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"paused":   true,
+			"ports":    []any{int64(80)},
+		},
+	}
+	type want struct {
+		value      string
+		compileErr bool
+		evalErr    bool
+	}
+	cases := map[string]struct {
+		reason string
+		expr   string
+		want   want
+	}{
+		"String": {
+			reason: "A string value should be returned as is.",
+			expr:   "object.metadata.labels.app",
+			want:   want{value: "bar"},
+		},
+		"Concatenation": {
+			reason: "Strings built from several fields should be returned.",
+			expr:   "object.metadata.name + '-' + object.metadata.labels.app",
+			want:   want{value: "foo-bar"},
+		},
+		"Number": {
+			reason: "A number should be converted to a string.",
+			expr:   "object.spec.replicas",
+			want:   want{value: "3"},
+		},
+		"Bool": {
+			reason: "A bool should be converted to a string.",
+			expr:   "object.spec.paused",
+			want:   want{value: "true"},
+		},
+		"List": {
+			reason: "A value that is not a scalar cannot be converted to a string.",
+			expr:   "object.spec.ports",
+			want:   want{evalErr: true},
+		},
+		"NotScalar": {
+			reason: "An expression that never evaluates to a scalar should be rejected.",
+			expr:   "[object.metadata.name]",
+			want:   want{compileErr: true},
+		},
+		"MissingField": {
+			reason: "An expression reading a missing field cannot be evaluated.",
+			expr:   "object.metadata.labels.missing",
+			want:   want{evalErr: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := CompileCELExpression(tc.expr)
+			if diff := cmp.Diff(tc.want.compileErr, err != nil); diff != "" {
+				t.Fatalf("\n%s\nCompileCELExpression(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			if err != nil {
+				return
+			}
+			got, err := e.Eval(object)
+			if diff := cmp.Diff(tc.want.evalErr, err != nil); diff != "" {
+				t.Fatalf("\n%s\ne.Eval(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			if diff := cmp.Diff(tc.want.value, got); diff != "" {
+				t.Errorf("\n%s\ne.Eval(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCELCostLimit(t *testing.T) {
+	items := make([]any, 1000)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	object := map[string]any{"spec": map[string]any{"items": items}}
+
+	cases := map[string]struct {
+		reason  string
+		expr    string
+		costErr bool
+		evalErr bool
+	}{
+		"Cheap": {
+			reason: "An expression within the cost limits should be admitted and evaluated.",
+			expr:   "object.spec.items.all(i, i >= 0)",
+		},
+		"OverCost": {
+			reason:  "An expression whose worst case cost exceeds the static limit should be rejected, and fail if it exceeds the runtime limit.",
+			expr:    "object.spec.items.all(i, object.spec.items.all(j, i == j || i != j))",
+			costErr: true,
+			evalErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := checkCELCost(tc.expr)
+			if diff := cmp.Diff(tc.costErr, err != nil); diff != "" {
+				t.Errorf("\n%s\ncheckCELCost(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			p, err := CompileCELPredicate(tc.expr)
+			if err != nil {
+				t.Fatalf("\n%s\nCompileCELPredicate(...): %v", tc.reason, err)
+			}
+			_, err = p.Eval(object)
+			if diff := cmp.Diff(tc.evalErr, err != nil); diff != "" {
+				t.Errorf("\n%s\np.Eval(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-kubernetes-crossplane-io-v1alpha1-providerconfig,mutating=false,failurePolicy=fail,groups=kubernetes.crossplane.io,resources=providerconfigs,versions=v1alpha1,name=providerconfigs.kubernetes.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// SetupWebhook adds a validating webhook for ProviderConfigs.
+func SetupWebhook(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.ProviderConfig{}).
+		WithValidator(&validator{}).
+		Complete()
+}
+
+// validator rejects ProviderConfigs whose validation rules do not compile.
+type validator struct{}
+
+var _ admission.Validator[*v1alpha1.ProviderConfig] = &validator{}
+
+// ValidateCreate validates a new ProviderConfig.
+func (v *validator) ValidateCreate(_ context.Context, pc *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	return nil, invalid(pc, validateProviderConfig(pc))
+}
+
+// ValidateUpdate validates a ProviderConfig whose spec changed.
+func (v *validator) ValidateUpdate(_ context.Context, oldPC, newPC *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	if meta.WasDeleted(newPC) || equality.Semantic.DeepEqual(oldPC.Spec, newPC.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(newPC, validateProviderConfig(newPC))
+}
+
+// ValidateDelete admits every deletion.
+func (v *validator) ValidateDelete(_ context.Context, _ *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func invalid(pc *v1alpha1.ProviderConfig, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(schema.GroupKind{Group: v1alpha1.Group, Kind: v1alpha1.ProviderConfigKind}, pc.GetName(), errs)
+}
+
+// validateProviderConfig runs the structural checks of the policies of the
+// supplied ProviderConfig.
+func validateProviderConfig(pc *v1alpha1.ProviderConfig) field.ErrorList {
+	return pcontroller.ValidateValidationRules(pc.Spec.ValidationRules, field.NewPath("spec", "validationRules"))
+}
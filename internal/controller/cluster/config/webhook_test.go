@@ -0,0 +1,73 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+// invalidFields returns the "type: field" pairs of an Invalid status error.
+func invalidFields(err error) []string {
+	if err == nil {
+		return nil
+	}
+	se, ok := err.(*kerrors.StatusError)
+	if !ok || se.ErrStatus.Details == nil {
+		return []string{err.Error()}
+	}
+	out := make([]string, 0, len(se.ErrStatus.Details.Causes))
+	for _, c := range se.ErrStatus.Details.Causes {
+		out = append(out, string(c.Type)+": "+c.Field)
+	}
+	return out
+}
+
+func TestValidateCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		rules  []kconfig.ValidationRule
+		want   []string
+	}{
+		"NoRules": {
+			reason: "A ProviderConfig without validation rules should be admitted.",
+		},
+		"ValidRule": {
+			reason: "A ProviderConfig whose validation rules compile should be admitted.",
+			rules:  []kconfig.ValidationRule{{Name: "replicas", Rule: "object.spec.replicas <= 3"}},
+		},
+		"InvalidRule": {
+			reason: "A ProviderConfig with a validation rule that does not compile should be rejected.",
+			rules: []kconfig.ValidationRule{
+				{Name: "replicas", Rule: "object.spec.replicas <= 3"},
+				{Name: "broken", Rule: "object.spec.replicas <="},
+			},
+			want: []string{"FieldValueInvalid: spec.validationRules[1].rule"},
+		},
+		"NotBool": {
+			reason: "A ProviderConfig with a validation rule that does not evaluate to a bool should be rejected.",
+			rules:  []kconfig.ValidationRule{{Name: "name", Rule: "'name'"}},
+			want:   []string{"FieldValueInvalid: spec.validationRules[0].rule"},
+		},
+		"OverCost": {
+			reason: "A ProviderConfig with a validation rule whose worst case cost exceeds the limit should be rejected.",
+			rules:  []kconfig.ValidationRule{{Name: "nested", Rule: "object.spec.a.all(x, object.spec.b.all(y, x == y))"}},
+			want:   []string{"FieldValueInvalid: spec.validationRules[0].rule"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1alpha1.ProviderConfig{}
+			pc.Spec.ValidationRules = tc.rules
+			_, err := (&validator{}).ValidateCreate(context.Background(), pc)
+			if diff := cmp.Diff(tc.want, invalidFields(err), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
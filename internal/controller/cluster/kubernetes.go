@@ -57,3 +57,15 @@ func SetupGated(mgr ctrl.Manager, o controller.Options, po pcontroller.Options)
 	}
 	return nil
 }
+
+// SetupWebhooks adds the validating webhooks of all Kubernetes resources to
+// the supplied manager.
+func SetupWebhooks(mgr ctrl.Manager) error {
+	if err := config.SetupWebhook(mgr); err != nil {
+		return err
+	}
+	if err := object.SetupWebhook(mgr); err != nil {
+		return err
+	}
+	return observedobjectcollection.SetupWebhook(mgr)
+}
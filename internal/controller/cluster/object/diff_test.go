@@ -0,0 +1,165 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	"github.com/crossplane-contrib/provider-kubernetes/internal/controller/cluster/object/fake"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+func TestObserveRemoteState(t *testing.T) {
+	syncer := &fake.ResourceSyncer{
+		GetObservedStateFn: func(_ context.Context, _ *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+			return current, nil
+		},
+		GetDesiredStateFn: func(_ context.Context, _ *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+			return manifest, nil
+		},
+	}
+	type args struct {
+		obj    *v1alpha2.Object
+		target client.Client
+		local  client.Client
+		pc     *kconfig.ProviderConfigSpec
+	}
+	type want struct {
+		exists    bool
+		upToDate  bool
+		err       error
+		violation bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"DoesNotExist": {
+			reason: "A missing remote object should be reported as not existing and not up-to-date.",
+			args: args{
+				obj: kubernetesObject(),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+			},
+		},
+		"UpToDate": {
+			reason: "A remote object equal to the desired state should be reported as up-to-date.",
+			args: args{
+				obj: kubernetesObject(),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource()
+						return nil
+					}),
+				},
+			},
+			want: want{exists: true, upToDate: true},
+		},
+		"Drifted": {
+			reason: "A remote object that differs from the desired state should not be reported as up-to-date.",
+			args: args{
+				obj: kubernetesObject(),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetLabels(map[string]string{"foo": "bar"})
+						})
+						return nil
+					}),
+				},
+			},
+			want: want{exists: true},
+		},
+		"LateInitialized": {
+			reason: "Late-initialized fields should be part of the desired state, as when the controller observes the Object.",
+			args: args{
+				obj: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.SetManagementPolicies(xpv2.ManagementPolicies{xpv2.ManagementActionAll})
+					obj.Spec.ForProvider.LateInitializePaths = []string{"metadata.labels"}
+				}),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetLabels(map[string]string{"foo": "bar"})
+						})
+						return nil
+					}),
+				},
+			},
+			want: want{exists: true, upToDate: true},
+		},
+		"ReferencesWithoutLocalClient": {
+			reason: "References cannot be resolved without access to the control plane.",
+			args: args{
+				obj: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.References = objectReferences()
+				}),
+			},
+			want: want{err: errors.New(errReferencesNeedLocalClient)},
+		},
+		"PolicyViolation": {
+			reason: "A manifest violating the policies of the provider config should be reported without observing the remote object.",
+			args: args{
+				obj: kubernetesObject(),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				pc: &kconfig.ProviderConfigSpec{
+					ValidationRules: []kconfig.ValidationRule{{Name: "never", Rule: "false"}},
+				},
+			},
+			want: want{violation: true},
+		},
+		"FailedToGet": {
+			reason: "Errors getting the remote object should be returned.",
+			args: args{
+				obj: kubernetesObject(),
+				target: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetObject)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := tc.args.pc
+			if spec == nil {
+				spec = &kconfig.ProviderConfigSpec{}
+			}
+			e, err := NewRemoteObserver(tc.args.local, false, nil, nil, nil).conn.newExternal(context.Background(), tc.args.obj, nil, spec, tc.args.target, nil)
+			if err != nil {
+				t.Fatalf("\n%s\nnewExternal(...): %v", tc.reason, err)
+			}
+			e.syncer = syncer
+			s, err := e.observeRemoteState(context.Background(), tc.args.obj)
+			if tc.want.violation {
+				if !isPolicyViolation(err) {
+					t.Fatalf("\n%s\nobserveRemoteState(...): want policy violation, got error: %v", tc.reason, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nobserveRemoteState(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.exists, s.Exists); diff != "" {
+				t.Errorf("\n%s\nobserveRemoteState(...): -want exists, +got exists:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, s.UpToDate()); diff != "" {
+				t.Errorf("\n%s\nobserveRemoteState(...).UpToDate(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+)
+
+const (
+	errExternalDeletion        = "remote %s %s with UID %s was deleted externally, applying external deletion policy %s"
+	errDeleteExternallyDeleted = "cannot delete Object whose remote object was deleted externally"
+
+	reasonExternalDeletion event.Reason = "ExternalDeletion"
+)
+
+// observeExternalDeletion handles a remote object that was deleted by someone
+// other than this provider, i.e. one that was observed before but is now
+// missing, according to the external deletion policy of the supplied Object.
+// Every deletion is recorded as a Warning event and counted in the external
+// deletions metric. A deletion that is already reported is not recorded
+// again.
+func (c *external) observeExternalDeletion(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) (managed.ExternalObservation, error) {
+	policy := obj.Spec.ExternalDeletionPolicy
+	if policy == "" {
+		policy = v1alpha2.ExternalDeletionPolicyRecreate
+	}
+
+	if !(remoteDeleted(obj) && policy == v1alpha2.ExternalDeletionPolicyReport) {
+		c.recorder.Event(obj, event.Warning(reasonExternalDeletion, errors.Errorf(errExternalDeletion, manifest.GetKind(), manifest.GetName(), obj.Status.AtProvider.UID, policy)))
+		pcontroller.ExternalDeletions.WithLabelValues(v1alpha2.ObjectGroupVersionKind.String(), obj.GetNamespace(), string(policy)).Inc()
+	}
+
+	switch policy {
+	case v1alpha2.ExternalDeletionPolicyReport:
+		obj.SetConditions(v1alpha2.RemoteDeleted(), xpv2.Unavailable().WithMessage("remote object was deleted externally"))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case v1alpha2.ExternalDeletionPolicyDeleteObject:
+		if err := c.localClient.Delete(ctx, obj); resource.IgnoreNotFound(err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDeleteExternallyDeleted)
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	default:
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+}
+
+// observeExternalRecreation reports that the remote object of the supplied
+// Object exists again if its external deletion was reported.
+func observeExternalRecreation(obj *v1alpha2.Object) {
+	if remoteDeleted(obj) {
+		obj.SetConditions(v1alpha2.RemoteObserved())
+	}
+}
+
+func remoteDeleted(obj *v1alpha2.Object) bool {
+	return obj.GetCondition(v1alpha2.TypeRemoteDeleted).Status == corev1.ConditionTrue
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+)
+
+// shouldLateInitialize returns true if the supplied Object has late
+// initialization paths and its management policies allow late initialization.
+func shouldLateInitialize(obj *v1alpha2.Object) bool {
+	return len(obj.Spec.ForProvider.LateInitializePaths) > 0 &&
+		sets.New[xpv2.ManagementAction](obj.GetManagementPolicies()...).
+			HasAny(xpv2.ManagementActionLateInitialize, xpv2.ManagementActionAll)
+}
+
+// lateInitialize copies the fields at the late initialization paths of the
+// supplied Object that are set in the observed remote object, but not in its
+// manifest, into the manifest. Fields are only copied if their parent is set
+// in the manifest, so that partial list items or objects are never created.
+// It returns true if the manifest was changed.
+func lateInitialize(obj *v1alpha2.Object, observed *unstructured.Unstructured) (bool, error) {
+	if !shouldLateInitialize(obj) {
+		return false, nil
+	}
+
+	// The manifest is decoded without parseManifest, which would default its
+	// name into the Object spec.
+	m := map[string]any{}
+	if err := json.Unmarshal(obj.Spec.ForProvider.Manifest.Raw, &m); err != nil {
+		return false, errors.Wrap(err, errUnmarshalTemplate)
+	}
+	desired := fieldpath.Pave(m)
+	current := fieldpath.Pave(observed.Object)
+
+	changed := false
+	for _, p := range obj.Spec.ForProvider.LateInitializePaths {
+		paths, err := current.ExpandWildcards(p)
+		if err != nil {
+			return false, errors.Wrap(err, errLateInitialize)
+		}
+		// Wildcards over objects expand in random order.
+		sort.Strings(paths)
+		for _, path := range paths {
+			ok, err := lateInitializeField(desired, current, path)
+			if err != nil {
+				return false, errors.Wrap(err, errLateInitialize)
+			}
+			changed = changed || ok
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return false, errors.Wrap(err, errLateInitialize)
+	}
+	obj.Spec.ForProvider.Manifest.Raw = raw
+	return true, nil
+}
+
+// lateInitializeField copies the value at the supplied path from current to
+// desired if it is unset in desired and its parent is set. It returns true if
+// desired was changed.
+func lateInitializeField(desired, current *fieldpath.Paved, path string) (bool, error) {
+	if _, err := desired.GetValue(path); !fieldpath.IsNotFound(err) {
+		return false, err
+	}
+	segments, err := fieldpath.Parse(path)
+	if err != nil {
+		return false, err
+	}
+	if len(segments) > 1 {
+		if _, err := desired.GetValue(segments[:len(segments)-1].String()); err != nil {
+			if fieldpath.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	v, err := current.GetValue(path)
+	if err != nil {
+		return false, err
+	}
+	return true, desired.SetValue(path, v)
+}
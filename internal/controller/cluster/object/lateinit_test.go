@@ -0,0 +1,100 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+)
+
+func TestLateInitialize(t *testing.T) {
+	service := func(spec map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "foo", "namespace": "bar"},
+			"spec":       spec,
+		}}
+	}
+	observed := service(map[string]any{
+		"clusterIP": "10.0.0.1",
+		"type":      "NodePort",
+		"ports": []any{
+			map[string]any{"port": int64(80), "nodePort": int64(30080)},
+			map[string]any{"port": int64(443), "nodePort": int64(30443)},
+		},
+	})
+	manifest := `{"apiVersion":"v1","kind":"Service","metadata":{"namespace":"bar"},"spec":{"type":"NodePort","ports":[{"port":80}]}}`
+
+	type args struct {
+		policies xpv2.ManagementPolicies
+		paths    []string
+	}
+	type want struct {
+		changed  bool
+		manifest string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoPaths": {
+			reason: "Nothing should be late-initialized without late initialization paths.",
+			args: args{
+				policies: xpv2.ManagementPolicies{xpv2.ManagementActionAll},
+			},
+			want: want{manifest: manifest},
+		},
+		"LateInitializeNotAllowed": {
+			reason: "Nothing should be late-initialized if the management policies don't allow it.",
+			args: args{
+				policies: xpv2.ManagementPolicies{xpv2.ManagementActionObserve, xpv2.ManagementActionUpdate},
+				paths:    []string{"spec.clusterIP"},
+			},
+			want: want{manifest: manifest},
+		},
+		"LateInitialized": {
+			reason: "Unset fields should be copied from the observed object, but only into list items that exist in the manifest.",
+			args: args{
+				policies: xpv2.ManagementPolicies{xpv2.ManagementActionObserve, xpv2.ManagementActionLateInitialize},
+				paths:    []string{"spec.clusterIP", "spec.ports[*].nodePort", "spec.type", "spec.sessionAffinity"},
+			},
+			want: want{
+				changed:  true,
+				manifest: `{"apiVersion":"v1","kind":"Service","metadata":{"namespace":"bar"},"spec":{"clusterIP":"10.0.0.1","ports":[{"nodePort":30080,"port":80}],"type":"NodePort"}}`,
+			},
+		},
+		"ParentUnset": {
+			reason: "Fields whose parent is unset in the manifest should not be late-initialized.",
+			args: args{
+				policies: xpv2.ManagementPolicies{xpv2.ManagementActionAll},
+				paths:    []string{"metadata.labels.app"},
+			},
+			want: want{manifest: manifest},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj := kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ManagementPolicies = tc.args.policies
+				obj.Spec.ForProvider.LateInitializePaths = tc.args.paths
+				obj.Spec.ForProvider.Manifest.Raw = []byte(manifest)
+			})
+			changed, err := lateInitialize(obj, observed)
+			if err != nil {
+				t.Fatalf("\n%s\nlateInitialize(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want changed, +got changed:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.manifest, string(obj.Spec.ForProvider.Manifest.Raw)); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want manifest, +got manifest:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -37,7 +37,9 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -45,6 +47,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
 	kubernetesv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
 	"github.com/crossplane-contrib/provider-kubernetes/internal/controller/cluster/object/fake"
 	kubeclient "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client"
 	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
@@ -295,9 +298,11 @@ func TestConnect(t *testing.T) {
 
 func TestObserve(t *testing.T) {
 	type args struct {
-		client resource.ClientApplicator
-		syncer ResourceSyncer
-		mg     resource.Managed
+		client     resource.ClientApplicator
+		syncer     ResourceSyncer
+		guardrails *kconfig.Guardrails
+		rules      []kconfig.ValidationRule
+		mg         resource.Managed
 	}
 	type want struct {
 		out managed.ExternalObservation
@@ -408,6 +413,355 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"GeneratedNameNotCreated": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GeneratedNameCreateIncomplete": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+					meta.SetExternalCreatePending(obj, time.Now())
+				}),
+			},
+			want: want{
+				err: errors.New(errCreateIncomplete),
+			},
+		},
+		"ExternalNameOverridesManifest": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					meta.SetExternalName(obj, "adopted")
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+							if key.Name != "adopted" {
+								return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+							}
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetName("adopted")
+							})
+							return nil
+						},
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"ReplacementAdopted": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RenamedNotReplaced": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.Replacement.Policy = v1alpha2.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ReplacementRefused": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.Replacement.Policy = v1alpha2.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errRemoteObjectReplaced, someUID, "new-uid"),
+			},
+		},
+		"ReplacementRefusedWhileDeleting": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.Replacement.Policy = v1alpha2.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+					obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedRecreate": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyRecreate
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedReport": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyReport
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExternallyDeletedRecreate": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExternallyDeletedReport": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyReport
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = externalResourceName
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExternallyDeletedDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(nil),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExternallyDeletedFailedToDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ExternalDeletionPolicy = v1alpha2.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteExternallyDeleted),
+			},
+		},
+		"KindNotAllowed": {
+			args: args{
+				mg:         kubernetesObject(),
+				guardrails: &kconfig.Guardrails{DeniedKinds: []kconfig.KindSelector{{Kind: "Namespace"}}},
+			},
+			want: want{
+				err: pcontroller.NewPolicyViolation(pcontroller.ReasonKindNotAllowed, "kind /v1, Kind=Namespace is not allowed by the guardrails of the provider config"),
+			},
+		},
+		"KindNotAllowedWhileDeleting": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				}),
+				guardrails: &kconfig.Guardrails{DeniedKinds: []kconfig.KindSelector{{Kind: "Namespace"}}},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ValidationRuleFailed": {
+			args: args{
+				mg: kubernetesObject(),
+				rules: []kconfig.ValidationRule{{
+					Name:    "labelled",
+					Rule:    "has(object.metadata.labels)",
+					Message: "objects must be labelled",
+				}},
+			},
+			want: want{
+				err: pcontroller.NewPolicyViolation(pcontroller.ReasonValidationRuleFailed, `manifest violates validation rule "labelled" of the provider config: objects must be labelled`),
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ForProvider.LateInitializePaths = []string{"metadata.labels"}
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetLabels(map[string]string{"kubernetes.io/metadata.name": externalResourceName})
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
 		"FailedToPatchFieldFromReferenceObject": {
 			args: args{
 				mg: kubernetesObject(func(obj *v1alpha2.Object) {
@@ -695,10 +1049,13 @@ func TestObserve(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
-				logger:      logging.NewNopLogger(),
-				client:      tc.args.client,
-				localClient: tc.args.client.Client,
-				syncer:      tc.args.syncer,
+				logger:          logging.NewNopLogger(),
+				recorder:        event.NewNopRecorder(),
+				client:          tc.args.client,
+				localClient:     tc.args.client.Client,
+				syncer:          tc.args.syncer,
+				guardrails:      tc.args.guardrails,
+				validationRules: tc.args.rules,
 			}
 			got, gotErr := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -714,6 +1071,7 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
+		client resource.ClientApplicator
 		mg     resource.Managed
 		syncer ResourceSyncer
 	}
@@ -747,7 +1105,7 @@ func TestCreate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return nil, errBoom
 					},
 				},
@@ -764,7 +1122,7 @@ func TestCreate(t *testing.T) {
 				    "kind": "Namespace" }`)
 				}),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						if desired.GetName() != testObjectName {
 							t.Errorf("Name should default to object name when not provider in manifest")
 						}
@@ -776,11 +1134,81 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"FailedToCreateWithGeneratedName": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockCreate: test.NewMockCreateFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateObject),
+			},
+		},
+		"SuccessRecordsRecreatedUID": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+							if uid := obj.(*v1alpha2.Object).Status.AtProvider.UID; uid != "new-uid" {
+								t.Errorf("The UID of the recreated object should be persisted, got %q", uid)
+							}
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						desired.SetUID("new-uid")
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessRecordsGeneratedName": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+							obj.SetName(obj.GetGenerateName() + "abcde")
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						if desired.GetName() != "foo-abcde" {
+							t.Errorf("Name should be the one generated by the API server, got %q", desired.GetName())
+						}
+						if meta.GetExternalName(obj) != "foo-abcde" {
+							t.Errorf("Generated name should be recorded as the external name, got %q", meta.GetExternalName(obj))
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"Success": {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return desired, nil
 					},
 				},
@@ -793,8 +1221,10 @@ func TestCreate(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
-				logger: logging.NewNopLogger(),
-				syncer: tc.args.syncer,
+				logger:      logging.NewNopLogger(),
+				client:      tc.args.client,
+				localClient: tc.args.client.Client,
+				syncer:      tc.args.syncer,
 			}
 			got, gotErr := e.Create(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -843,7 +1273,7 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return nil, errBoom
 					},
 				},
@@ -852,6 +1282,25 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errApplyObject),
 			},
 		},
+		"SuccessWithUIDPrecondition": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Spec.Replacement.UpdatePrecondition = true
+					obj.Status.AtProvider.UID = someUID
+				}),
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						if desired.GetUID() != someUID {
+							t.Errorf("Update should be preconditioned on the observed UID, got %q", desired.GetUID())
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"SuccessDefaultsToObjectName": {
 			args: args{
 				mg: kubernetesObject(func(obj *v1alpha2.Object) {
@@ -860,7 +1309,7 @@ func TestUpdate(t *testing.T) {
 				    "kind": "Namespace" }`)
 				}),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						if desired.GetName() != testObjectName {
 							t.Errorf("Name should default to object name when not provider in manifest")
 						}
@@ -876,7 +1325,7 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return desired, nil
 					},
 				},
@@ -982,6 +1431,30 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessWithUIDPrecondition": {
+			args: args{
+				mg: kubernetesObject(func(obj *v1alpha2.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockDelete: func(_ context.Context, _ client.Object, opts ...client.DeleteOption) error {
+							do := &client.DeleteOptions{}
+							for _, o := range opts {
+								o.ApplyToDelete(do)
+							}
+							if do.Preconditions == nil || do.Preconditions.UID == nil || *do.Preconditions.UID != someUID {
+								t.Errorf("expected a precondition on UID %q, got %v", someUID, do.Preconditions)
+							}
+							return nil
+						},
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"SuccessWithForegroundPropagation": {
 			args: args{
 				mg: kubernetesObject(func(obj *v1alpha2.Object) {
@@ -2031,3 +2504,219 @@ func TestSetAtProviderRemovesManagedFields(t *testing.T) {
 		})
 	}
 }
+
+func TestObserveDryRun(t *testing.T) {
+	dryRun := func(obj *v1alpha2.Object) {
+		obj.Spec.DryRun = true
+	}
+	labelled := func(res *unstructured.Unstructured) {
+		res.SetLabels(map[string]string{"foo": "bar"})
+	}
+	type args struct {
+		client client.Client
+		syncer ResourceSyncer
+		mg     *v1alpha2.Object
+	}
+	type want struct {
+		out    managed.ExternalObservation
+		err    error
+		reason xpv2.ConditionReason
+		diff   string
+		uid    string
+	}
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"WouldCreate": {
+			reason: "A dry run against a missing remote object should report the whole object as the diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *v1alpha2.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+						if !create {
+							return nil, errBoom
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: v1alpha2.ReasonDryRunCreate,
+				diff:   `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"crossplane-system"}}`,
+			},
+		},
+		"WouldUpdate": {
+			reason: "A dry run that changes the remote object should report the changes as the diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetResourceVersion("1")
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *v1alpha2.Object, _ *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+						if create {
+							return nil, errBoom
+						}
+						return externalResource(labelled, func(res *unstructured.Unstructured) {
+							res.SetResourceVersion("2")
+						}), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: v1alpha2.ReasonDryRunUpdate,
+				diff:   `{"metadata":{"labels":{"foo":"bar"}}}`,
+			},
+		},
+		"NotTakenOver": {
+			reason: "A dry run against an existing remote object should not record it as the remote object of the Object.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *v1alpha2.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						}), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: v1alpha2.ReasonDryRunNoChanges,
+				diff:   `{}`,
+			},
+		},
+		"NoChanges": {
+			reason: "A dry run that does not change the remote object should report an empty diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource()
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *v1alpha2.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return externalResource(), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: v1alpha2.ReasonDryRunNoChanges,
+				diff:   `{}`,
+			},
+		},
+		"DryRunFailed": {
+			reason: "A dry run rejected by the API server should be returned and reflected in the condition.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *v1alpha2.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err:    errBoom,
+				reason: v1alpha2.ReasonDryRunFailed,
+			},
+		},
+		"Deleting": {
+			reason: "An Object in dry run should be released on deletion without touching the remote object.",
+			args: args{
+				mg: kubernetesObject(dryRun, func(obj *v1alpha2.Object) {
+					now := metav1.Now()
+					obj.SetDeletionTimestamp(&now)
+				}),
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DeletingManaged": {
+			reason: "An Object that managed its remote object before dry run was enabled should observe it on deletion, so that it is deleted rather than orphaned.",
+			args: args{
+				mg: kubernetesObject(dryRun, func(obj *v1alpha2.Object) {
+					now := metav1.Now()
+					obj.SetDeletionTimestamp(&now)
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(_ context.Context, _ *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(_ context.Context, _ *v1alpha2.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true},
+				uid: someUID,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				logger:      logging.NewNopLogger(),
+				client:      resource.ClientApplicator{Client: tc.args.client},
+				localClient: tc.args.client,
+				syncer:      tc.args.syncer,
+			}
+			got, gotErr := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.Observe(...): -want error, +got error: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want out, +got out: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, tc.args.mg.GetCondition(v1alpha2.TypeDryRun).Reason); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want reason, +got reason: %s", tc.reason, diff)
+			}
+			gotDiff := ""
+			if r := tc.args.mg.Status.AtProvider.DryRun; r != nil {
+				gotDiff = r.Diff
+			}
+			if diff := cmp.Diff(tc.want.diff, gotDiff); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want diff, +got diff: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.uid, string(tc.args.mg.Status.AtProvider.UID)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want uid, +got uid: %s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	errListPCUsages = "cannot list provider config usages"
+)
+
+// isPolicyViolation returns true if the supplied error is a policy violation.
+func isPolicyViolation(err error) bool {
+	_, ok := pcontroller.AsPolicyViolation(err)
+	return ok
+}
+
+// targetManifest parses the manifest of the supplied Object and checks it
+// against the guardrails of its provider config. A violation is reported in
+// the PolicyCompliant condition of the Object and in the status of the
+// provider config.
+func (c *external) targetManifest(ctx context.Context, obj *v1alpha2.Object) (*unstructured.Unstructured, error) {
+	manifest, err := c.policyManifest(ctx, obj)
+	if v, ok := pcontroller.AsPolicyViolation(err); ok {
+		obj.SetConditions(v1alpha2.PolicyViolated(v.Reason, v.Message))
+		if err := c.recordPolicyViolation(ctx, obj, v); err != nil {
+			return nil, err
+		}
+		return nil, v
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.GetCondition(v1alpha2.TypePolicyCompliant).Status == corev1.ConditionFalse {
+		obj.SetConditions(v1alpha2.PolicyCompliant())
+	}
+	if err := c.recordPolicyViolation(ctx, obj, nil); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// policyManifest parses the manifest of the supplied Object and applies the
+// policies of its provider config to it, without recording the outcome.
+func (c *external) policyManifest(ctx context.Context, obj *v1alpha2.Object) (*unstructured.Unstructured, error) {
+	manifest, err := parseManifest(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPolicies(ctx, obj, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// checkPolicies checks the supplied manifest against the guardrails and
+// validation rules of the provider config.
+func (c *external) checkPolicies(ctx context.Context, obj *v1alpha2.Object, manifest *unstructured.Unstructured) error {
+	if err := pcontroller.CheckGuardrails(c.guardrails, manifest, c.isNamespaced); err != nil {
+		return err
+	}
+	if err := pcontroller.CheckValidationRules(c.validationRules, manifest); err != nil {
+		return err
+	}
+	if c.guardrails == nil || c.guardrails.MaxObjects == nil || obj.Status.AtProvider.UID != "" || c.localClient == nil {
+		// Only Objects that are yet to create or adopt a remote object are
+		// subject to the maximum number of Objects, which can only be
+		// counted with access to the control plane.
+		return nil
+	}
+	usages, err := c.providerConfigUsages(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return pcontroller.CheckMaxObjects(c.guardrails, obj.GetUID(), usages)
+}
+
+// isNamespaced returns true if the supplied object is namespace-scoped in the
+// target cluster.
+func (c *external) isNamespaced(o runtime.Object) (bool, error) {
+	return c.client.IsObjectNamespaced(o)
+}
+
+// providerConfigUsages returns the usages of the provider config of the
+// supplied Object.
+func (c *external) providerConfigUsages(ctx context.Context, obj *v1alpha2.Object) ([]resource.ProviderConfigUsage, error) {
+	l := &apisv1alpha1.ProviderConfigUsageList{}
+	if err := c.localClient.List(ctx, l, client.MatchingLabels{xpv2.LabelKeyProviderName: obj.GetProviderConfigReference().Name}); err != nil {
+		return nil, errors.Wrap(err, errListPCUsages)
+	}
+	usages := make([]resource.ProviderConfigUsage, len(l.Items))
+	for i := range l.Items {
+		usages[i] = &l.Items[i]
+	}
+	return usages, nil
+}
+
+// recordPolicyViolation records the supplied policy violation of the supplied
+// Object in the status of its provider config. A nil violation removes any
+// recorded violation of the Object.
+func (c *external) recordPolicyViolation(ctx context.Context, obj *v1alpha2.Object, v *pcontroller.PolicyViolation) error {
+	if c.providerConfig == nil {
+		return nil
+	}
+	return pcontroller.RecordPolicyViolation(ctx, c.localClient, c.providerConfig, kconfig.PolicyViolation{
+		Kind: v1alpha2.ObjectKind,
+		Name: obj.GetName(),
+	}, v)
+}
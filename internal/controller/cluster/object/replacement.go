@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+)
+
+const (
+	errRemoteObjectReplaced = "remote object has been replaced: observed UID %s, found UID %s"
+	errRecordCreatedUID     = "cannot record UID of the created object"
+
+	reasonRemoteObjectReplaced event.Reason = "RemoteObjectReplaced"
+)
+
+// setRemoteIdentity records the name, namespace, UID, resource version and
+// generation of the supplied remote object in the status of the supplied
+// Object.
+func setRemoteIdentity(obj *v1alpha2.Object, observed *unstructured.Unstructured) {
+	obj.Status.AtProvider.UID = observed.GetUID()
+	obj.Status.AtProvider.Name = observed.GetName()
+	obj.Status.AtProvider.Namespace = observed.GetNamespace()
+	obj.Status.AtProvider.ResourceVersion = observed.GetResourceVersion()
+	obj.Status.AtProvider.Generation = observed.GetGeneration()
+}
+
+// clearRemoteIdentity forgets the remote object last observed for the
+// supplied Object.
+func clearRemoteIdentity(obj *v1alpha2.Object) {
+	obj.Status.AtProvider.UID = ""
+	obj.Status.AtProvider.Name = ""
+	obj.Status.AtProvider.Namespace = ""
+	obj.Status.AtProvider.ResourceVersion = ""
+	obj.Status.AtProvider.Generation = 0
+}
+
+// remoteIdentityMatches returns true if the remote object last observed for
+// the supplied Object has the name and namespace of the supplied manifest,
+// i.e. if the manifest was not renamed since. Objects observed before their
+// name was recorded are assumed to match.
+func remoteIdentityMatches(obj *v1alpha2.Object, manifest *unstructured.Unstructured) bool {
+	a := obj.Status.AtProvider
+	return a.Name == "" || (a.Name == manifest.GetName() && a.Namespace == manifest.GetNamespace())
+}
+
+// observeReplacement checks whether the supplied remote object replaced the
+// one last observed for the supplied Object, i.e. whether it has the same
+// name and namespace, but another UID.
+// A replacement is recorded as an event and handled according to the
+// replacement policy of the Object: it is either adopted, or refused with an
+// error. It returns true if the remote object must be treated as absent,
+// which is the case for a refused replacement of an Object that is being
+// deleted, so that the replacement is left alone.
+func (c *external) observeReplacement(obj *v1alpha2.Object, current *unstructured.Unstructured) (bool, error) {
+	uid := obj.Status.AtProvider.UID
+	if uid == "" || uid == current.GetUID() || !remoteIdentityMatches(obj, current) {
+		// A remote object under another name than the one last observed
+		// is another object rather than a replacement.
+		return false, nil
+	}
+
+	if obj.Spec.Replacement.Policy != v1alpha2.ReplacementPolicyRefuse {
+		c.recorder.Event(obj, event.Normal(reasonRemoteObjectReplaced, fmt.Sprintf("Adopted remote object with UID %s, which replaced the one with UID %s", current.GetUID(), uid)))
+		return false, nil
+	}
+
+	err := errors.Errorf(errRemoteObjectReplaced, uid, current.GetUID())
+	c.recorder.Event(obj, event.Warning(reasonRemoteObjectReplaced, err))
+	if meta.WasDeleted(obj) {
+		return true, nil
+	}
+	return false, err
+}
+
+// recordCreatedUID persists the UID of the remote object the supplied Object
+// created if it differs from the recorded one, i.e. if the Object recreated a
+// remote object that went missing. The managed reconciler discards status
+// changes made by Create, so the recreated remote object would otherwise look
+// replaced on the next observation.
+func (c *external) recordCreatedUID(ctx context.Context, obj *v1alpha2.Object, created *unstructured.Unstructured) error {
+	uid := created.GetUID()
+	if uid == "" || obj.Status.AtProvider.UID == "" || uid == obj.Status.AtProvider.UID {
+		return nil
+	}
+
+	// Updating the status refreshes the Object from the API server, which
+	// would drop annotations set by Create, e.g. the external name.
+	a := obj.GetAnnotations()
+	setRemoteIdentity(obj, created)
+	err := c.localClient.Status().Update(ctx, obj)
+	meta.AddAnnotations(obj, a)
+	return errors.Wrap(err, errRecordCreatedUID)
+}
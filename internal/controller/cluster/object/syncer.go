@@ -9,7 +9,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/csaupgrade"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/extractor"
 	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/state"
 )
 
@@ -28,6 +32,17 @@ type PatchingResourceSyncer struct {
 	client resource.ClientApplicator
 }
 
+// NewPatchingResourceSyncer returns a PatchingResourceSyncer that syncs
+// objects to the Kubernetes API server of the supplied client.
+func NewPatchingResourceSyncer(k client.Client) *PatchingResourceSyncer {
+	return &PatchingResourceSyncer{
+		client: resource.ClientApplicator{
+			Client:     k,
+			Applicator: resource.NewAPIPatchingApplicator(k),
+		},
+	}
+}
+
 // GetObservedState returns the last applied configuration of the supplied
 // object, if it exists.
 func (p *PatchingResourceSyncer) GetObservedState(_ context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -39,9 +54,7 @@ func (p *PatchingResourceSyncer) GetObservedState(_ context.Context, obj *v1alph
 	if err := json.Unmarshal([]byte(lastApplied), last); err != nil {
 		return nil, errors.Wrap(err, errUnmarshalTemplate)
 	}
-	if last.GetName() == "" {
-		last.SetName(obj.Name)
-	}
+	setRemoteName(obj, last)
 	return last, nil
 }
 
@@ -53,7 +66,7 @@ func (p *PatchingResourceSyncer) GetDesiredState(_ context.Context, obj *v1alpha
 // SyncResource syncs the supplied object by storing the last applied
 // configuration in an annotation and patching the object in the Kubernetes API
 // server.
-func (p *PatchingResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (p *PatchingResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 	meta.AddAnnotations(desired, map[string]string{
 		v1.LastAppliedConfigAnnotation: string(obj.Spec.ForProvider.Manifest.Raw),
 	})
@@ -65,16 +78,63 @@ func (p *PatchingResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2
 	return desired, nil
 }
 
+// DryRunResource creates or merge patches the supplied object in the
+// Kubernetes API server with all changes dry-run, the same way SyncResource
+// does through the applicator.
+func (p *PatchingResourceSyncer) DryRunResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+	meta.AddAnnotations(desired, map[string]string{
+		v1.LastAppliedConfigAnnotation: string(obj.Spec.ForProvider.Manifest.Raw),
+	})
+
+	var err error
+	if create {
+		err = p.client.Create(ctx, desired, client.DryRunAll)
+	} else {
+		var patch []byte
+		if patch, err = json.Marshal(desired); err != nil {
+			return nil, errors.Wrap(err, errDryRunObject)
+		}
+		err = p.client.Patch(ctx, desired, client.RawPatch(types.MergePatchType, patch), client.DryRunAll)
+	}
+	if err != nil {
+		return nil, errors.Wrap(CleanErr(err), errDryRunObject)
+	}
+	return desired, nil
+}
+
 // SSAResourceSyncer is a ResourceSyncer that syncs objects by using server-side
 // apply to apply the object's manifest to the Kubernetes API server.
 type SSAResourceSyncer struct {
 	client              client.Client
 	extractor           applymetav1.UnstructuredExtractor
+	validator           extractor.SchemaValidator
 	desiredStateCacheFn func() state.Cache
 	// for csa -> ssa migration
 	legacyCSAFieldManagers sets.Set[string]
 }
 
+// NewSSAResourceSyncer returns an SSAResourceSyncer that syncs objects to the
+// Kubernetes API server at the supplied REST config. The OpenAPI schemas of
+// the server are cached in the supplied parser cache, and desired states in
+// the cache returned by desiredStateCacheFn.
+func NewSSAResourceSyncer(ctx context.Context, k client.Client, rc *rest.Config, parserCache *extractor.GVKParserCache, desiredStateCacheFn func() state.Cache, legacyCSAFieldManagers sets.Set[string]) (*SSAResourceSyncer, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(rc)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateDiscoveryClient)
+	}
+	applyExtractor, err := extractor.NewCachingUnstructuredExtractor(ctx, dc, parserCache)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSSAExtractor)
+	}
+	return &SSAResourceSyncer{
+		client:                 k,
+		extractor:              applyExtractor,
+		validator:              extractor.NewCachingSchemaValidator(ctx, dc, parserCache),
+		desiredStateCacheFn:    desiredStateCacheFn,
+		legacyCSAFieldManagers: legacyCSAFieldManagers,
+	}, nil
+}
+
 // GetObservedState returns the object's observed state by extracting the
 // managed fields from the current object.
 func (s *SSAResourceSyncer) GetObservedState(_ context.Context, obj *v1alpha2.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
@@ -128,7 +188,10 @@ func (s *SSAResourceSyncer) GetDesiredState(ctx context.Context, obj *v1alpha2.O
 }
 
 // SyncResource syncs the supplied object by using server-side apply to apply.
-func (s *SSAResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (s *SSAResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+	if err := s.validate(obj, desired, create); err != nil {
+		return nil, err
+	}
 	// first, upgrade managed fields to SSA manager if needed
 	err := s.maybeUpgradeFieldManagers(ctx, obj)
 	if err != nil {
@@ -140,6 +203,47 @@ func (s *SSAResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha2.Obje
 	return desired, nil
 }
 
+// DryRunResource applies the supplied object with server-side apply with all
+// changes dry-run. Legacy field managers are not upgraded, as that would
+// persist a change.
+func (s *SSAResourceSyncer) DryRunResource(ctx context.Context, obj *v1alpha2.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+	if err := s.validate(obj, desired, create); err != nil {
+		return nil, err
+	}
+	if err := s.client.Patch(ctx, desired, client.Apply, client.ForceOwnership, client.FieldOwner(ssaFieldOwner(obj.GetName())), client.DryRunAll); err != nil { //nolint:staticcheck // SA1019: keeping client.Apply until controller-runtime's Client.Apply is available on all supported paths
+		return nil, errors.Wrap(CleanErr(err), errDryRunObject)
+	}
+	return desired, nil
+}
+
+// validate checks the supplied manifest against the OpenAPI schema of its kind
+// in the target cluster, so that fields the API server would silently prune
+// or reject are reported before anything is applied. The outcome is recorded
+// in the ManifestValid condition of the Object. Missing required fields are
+// only reported if the remote object is about to be created, since
+// server-side apply validates the manifest merged with the existing object
+// otherwise.
+func (s *SSAResourceSyncer) validate(obj *v1alpha2.Object, manifest *unstructured.Unstructured, create bool) error {
+	if s.validator == nil {
+		return nil
+	}
+	errs, err := s.validator.Validate(manifest, create)
+	if err != nil {
+		return errors.Wrap(err, errValidateManifest)
+	}
+	if len(errs) == 0 {
+		obj.SetConditions(v1alpha2.ManifestValid())
+		return nil
+	}
+	mp := field.NewPath("spec", "forProvider", "manifest")
+	for _, e := range errs {
+		e.Field = mp.Child(e.Field).String()
+	}
+	msg := errs.ToAggregate().Error()
+	obj.SetConditions(v1alpha2.ManifestInvalid(msg))
+	return errors.Errorf("%s: %s", errInvalidManifest, msg)
+}
+
 // needSSAFieldManagerUpgrade checks the given k8s resource has legacy CSA field
 // managers in the managed field entries.
 func (s *SSAResourceSyncer) needSSAFieldManagerUpgrade(accessor metav1.Object) bool {
@@ -3,9 +3,17 @@ package object
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
 )
 
 func TestNeedSSAFieldManagerUpgrade(t *testing.T) {
@@ -78,3 +86,88 @@ func managedFieldsEntry(manager, subresource, raw string) metav1.ManagedFieldsEn
 		},
 	}
 }
+
+type mockSchemaValidator struct {
+	errs   field.ErrorList
+	err    error
+	create bool
+}
+
+func (m *mockSchemaValidator) Validate(_ *unstructured.Unstructured, create bool) (field.ErrorList, error) {
+	m.create = create
+	return m.errs, m.err
+}
+
+func TestValidate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err       error
+		condition corev1.ConditionStatus
+		message   string
+		create    bool
+	}
+	cases := map[string]struct {
+		reason    string
+		validator *mockSchemaValidator
+		create    bool
+		want      want
+	}{
+		"Valid": {
+			reason:    "A manifest without violations should be marked valid.",
+			validator: &mockSchemaValidator{},
+			want: want{
+				condition: corev1.ConditionTrue,
+			},
+		},
+		"NotCreated": {
+			reason:    "Missing required fields should be validated if the remote object is about to be created.",
+			validator: &mockSchemaValidator{},
+			create:    true,
+			want: want{
+				condition: corev1.ConditionTrue,
+				create:    true,
+			},
+		},
+		"Invalid": {
+			reason: "Violations should be reported relative to the Object, both in the condition and the error.",
+			validator: &mockSchemaValidator{errs: field.ErrorList{
+				field.Forbidden(field.NewPath("spec.replica"), "field not declared in schema and would be pruned"),
+				field.Required(field.NewPath("spec.selector"), "required by schema"),
+			}},
+			want: want{
+				err:       errors.New(errInvalidManifest + ": [spec.forProvider.manifest.spec.replica: Forbidden: field not declared in schema and would be pruned, spec.forProvider.manifest.spec.selector: Required value: required by schema]"),
+				condition: corev1.ConditionFalse,
+				message:   "[spec.forProvider.manifest.spec.replica: Forbidden: field not declared in schema and would be pruned, spec.forProvider.manifest.spec.selector: Required value: required by schema]",
+			},
+		},
+		"SchemaUnavailable": {
+			reason:    "An error retrieving the schema should be returned without setting the condition.",
+			validator: &mockSchemaValidator{err: errBoom},
+			want: want{
+				err:       errors.Wrap(errBoom, errValidateManifest),
+				condition: corev1.ConditionUnknown,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj := kubernetesObject()
+			s := &SSAResourceSyncer{validator: tc.validator}
+			err := s.validate(obj, &unstructured.Unstructured{}, tc.create)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.create, tc.validator.create); diff != "" {
+				t.Errorf("\n%s\nvalidate(...): -want create, +got create:\n%s", tc.reason, diff)
+			}
+			c := obj.GetCondition(v1alpha2.TypeManifestValid)
+			if diff := cmp.Diff(tc.want.condition, c.Status); diff != "" {
+				t.Errorf("\n%s\nvalidate(...): -want condition status, +got condition status:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.message, c.Message); diff != "" {
+				t.Errorf("\n%s\nvalidate(...): -want condition message, +got condition message:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
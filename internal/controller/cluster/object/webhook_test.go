@@ -0,0 +1,171 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+)
+
+// invalidFields returns the "type: field" pairs of an Invalid status error.
+func invalidFields(err error) []string {
+	if err == nil {
+		return nil
+	}
+	se, ok := err.(*kerrors.StatusError)
+	if !ok || se.ErrStatus.Details == nil {
+		return []string{err.Error()}
+	}
+	out := make([]string, 0, len(se.ErrStatus.Details.Causes))
+	for _, c := range se.ErrStatus.Details.Causes {
+		out = append(out, string(c.Type)+": "+c.Field)
+	}
+	return out
+}
+
+func TestValidateCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		obj    *v1alpha2.Object
+		want   []string
+		// warnings are expected admission warnings.
+		warnings []string
+	}{
+		"Valid": {
+			reason: "A well-formed Object should be admitted.",
+			obj:    kubernetesObject(),
+		},
+		"BadJSON": {
+			reason: "A manifest that parseManifest cannot decode should be rejected.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": `)
+			}),
+			want: []string{"FieldValueInvalid: spec.forProvider.manifest"},
+		},
+		"MissingAPIVersion": {
+			reason: "A manifest without an apiVersion should be rejected.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ForProvider.Manifest.Raw = []byte(`{"kind": "ConfigMap", "metadata": {"name": "foo"}}`)
+			}),
+			want: []string{"FieldValueRequired: spec.forProvider.manifest.apiVersion"},
+		},
+		"NamespaceOnClusterScopedKind": {
+			reason: "A namespace on a well-known cluster-scoped kind should be rejected.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "rbac.authorization.k8s.io/v1", "kind": "ClusterRole", "metadata": {"name": "foo", "namespace": "bar"}}`)
+			}),
+			want: []string{"FieldValueForbidden: spec.forProvider.manifest.metadata.namespace"},
+		},
+		"BrokenLateInitializePath": {
+			reason: "An invalid late initialization path should be rejected.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ForProvider.LateInitializePaths = []string{"spec.clusterIP", "spec.ports[0"}
+			}),
+			want: []string{"FieldValueInvalid: spec.forProvider.lateInitializePaths[1]"},
+		},
+		"BrokenReferences": {
+			reason: "References with an invalid field path should be rejected, while references without a target are admitted with a warning.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.References = []v1alpha2.Reference{
+					{},
+					{
+						PatchesFrom: &v1alpha2.PatchesFrom{
+							DependsOn: v1alpha2.DependsOn{APIVersion: "v1", Kind: "ConfigMap", Name: "foo", Namespace: "bar"},
+							FieldPath: ptr.To("data[foo"),
+						},
+					},
+				}
+			}),
+			want: []string{
+				"FieldValueInvalid: spec.references[1].patchesFrom.fieldPath",
+			},
+			warnings: []string{"spec.references[0]: neither dependsOn nor patchesFrom is set, the reference is ignored"},
+		},
+		"PartlyIgnoredReferences": {
+			reason: "References that the controller accepts but partly ignores should be admitted with a warning.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				d := v1alpha2.DependsOn{APIVersion: "v1", Kind: "ConfigMap", Name: "foo", Namespace: "bar"}
+				obj.Spec.References = []v1alpha2.Reference{
+					{DependsOn: &d, PatchesFrom: &v1alpha2.PatchesFrom{DependsOn: d, FieldPath: ptr.To("data.foo")}},
+					{PatchesFrom: &v1alpha2.PatchesFrom{DependsOn: d}},
+					{DependsOn: &d, ToFieldPath: ptr.To("data.foo")},
+				}
+			}),
+			warnings: []string{
+				"spec.references[0].dependsOn: dependsOn is ignored because patchesFrom is set",
+				"spec.references[1].patchesFrom.fieldPath: fieldPath is not set, the reference only declares a dependency",
+				"spec.references[2].toFieldPath: toFieldPath is ignored without patchesFrom",
+			},
+		},
+		"BrokenConnectionDetail": {
+			reason: "Connection details without a secret key should be rejected.",
+			obj: kubernetesObject(func(obj *v1alpha2.Object) {
+				obj.Spec.ConnectionDetails = []v1alpha2.ConnectionDetail{{}}
+				obj.Spec.ConnectionDetails[0].APIVersion = "v1"
+				obj.Spec.ConnectionDetails[0].Kind = "Secret"
+				obj.Spec.ConnectionDetails[0].Name = "foo"
+				obj.Spec.ConnectionDetails[0].FieldPath = "data.foo"
+			}),
+			want: []string{"FieldValueRequired: spec.connectionDetails[0].toConnectionSecretKey"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := (&validator{}).ValidateCreate(context.Background(), tc.obj)
+			if diff := cmp.Diff(tc.want, invalidFields(err), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.warnings, []string(warnings), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want warnings, +got warnings:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	broken := func(obj *v1alpha2.Object) {
+		obj.Spec.ForProvider.Manifest.Raw = []byte(`{"kind": "ConfigMap"}`)
+	}
+	cases := map[string]struct {
+		reason string
+		old    *v1alpha2.Object
+		new    *v1alpha2.Object
+		want   []string
+	}{
+		"SpecUnchanged": {
+			reason: "Updates that do not touch the spec should be admitted even for invalid Objects.",
+			old:    kubernetesObject(broken),
+			new: kubernetesObject(broken, func(obj *v1alpha2.Object) {
+				obj.SetFinalizers([]string{objFinalizerName})
+			}),
+		},
+		"Deleting": {
+			reason: "Updates of an Object that is being deleted should be admitted.",
+			old:    kubernetesObject(),
+			new: kubernetesObject(broken, func(obj *v1alpha2.Object) {
+				now := metav1.Now()
+				obj.SetDeletionTimestamp(&now)
+			}),
+		},
+		"SpecChanged": {
+			reason: "Spec changes should be validated.",
+			old:    kubernetesObject(),
+			new:    kubernetesObject(broken),
+			want:   []string{"FieldValueRequired: spec.forProvider.manifest.apiVersion"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&validator{}).ValidateUpdate(context.Background(), tc.old, tc.new)
+			if diff := cmp.Diff(tc.want, invalidFields(err), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidateUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
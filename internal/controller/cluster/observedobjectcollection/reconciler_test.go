@@ -19,25 +19,31 @@ package observedobjectcollection
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
 
@@ -48,14 +54,89 @@ import (
 	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
 )
 
+type kindObserverFn func(rc *rest.Config, providerConfig string, gvks ...schema.GroupVersionKind)
+
+func (fn kindObserverFn) WatchResources(rc *rest.Config, providerConfig string, gvks ...schema.GroupVersionKind) {
+	fn(rc, providerConfig, gvks...)
+}
+
+func readyObject(name string) v1alpha2.Object {
+	o := v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	o.SetConditions(xpv2.Available())
+	return o
+}
+
+// matchedItems adds n items of the listed kind to the supplied list, each
+// modified by the supplied function.
+func matchedItems(list client.ObjectList, n int, fn func(i int, o metav1.Object)) error {
+	gvk := list.GetObjectKind().GroupVersionKind()
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	for i := 0; i < n; i++ {
+		switch l := list.(type) {
+		case *unstructured.UnstructuredList:
+			item := unstructured.Unstructured{}
+			item.SetGroupVersionKind(gvk)
+			fn(i, &item)
+			l.Items = append(l.Items, item)
+		case *metav1.PartialObjectMetadataList:
+			item := metav1.PartialObjectMetadata{}
+			item.SetGroupVersionKind(gvk)
+			fn(i, &item)
+			l.Items = append(l.Items, item)
+		default:
+			return fmt.Errorf("Unexpected list %T", list)
+		}
+	}
+	return nil
+}
+
+// adoptedList returns true if the supplied options list the Objects adopted
+// from a collection rather than its members.
+func adoptedList(opts []client.ListOption) bool {
+	lo := &client.ListOptions{}
+	lo.ApplyOptions(opts)
+	if lo.LabelSelector == nil {
+		return false
+	}
+	_, ok := lo.LabelSelector.RequiresExactMatch(adoptedLabelKey)
+	return ok
+}
+
 func TestReconciler(t *testing.T) {
 	collectionName := types.NamespacedName{Name: "col"}
 	errBoom := fmt.Errorf("error reading")
 	pollIterval := 10 * time.Second
 	objectAPIVersion := "v1"
 	objectKind := "Foo"
+	// unchanged is a collection whose only member is up to date.
+	unchanged := &v1alpha1.ObservedObjectCollection{
+		ObjectMeta: metav1.ObjectMeta{Name: collectionName.Name},
+		Spec: v1alpha1.ObservedObjectCollectionSpec{
+			ObserveObjects: v1alpha1.ObserveObjectCriteria{
+				APIVersion: objectAPIVersion,
+				Kind:       objectKind,
+			},
+			ProviderConfigReference: xpv2.Reference{
+				Name: "name",
+			},
+		},
+	}
+	// deleted is a collection that is being deleted.
+	deleted := unchanged.DeepCopy()
+	deleted.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	deleted.SetFinalizers([]string{collectionFinalizer})
+	deleted.SetUID("col-uid")
+	member := func(name string) v1alpha2.Object {
+		o := readyObject(name)
+		o.SetLabels(map[string]string{membershipLabelKey: collectionName.Name, criteriaLabelKey: "0", "app": "web"})
+		o.SetAnnotations(map[string]string{memberHashAnnotationKey: "hash"})
+		o.SetOwnerReferences([]metav1.OwnerReference{{Name: collectionName.Name, UID: "col-uid"}, {Name: "other", UID: "other-uid"}})
+		return o
+	}
 	type args struct {
-		client *test.MockClient
+		client       *test.MockClient
+		kindObserver kindObserver
+		finalizer    resource.Finalizer
 	}
 	type want struct {
 		r   reconcile.Result
@@ -71,114 +152,1010 @@ func TestReconciler(t *testing.T) {
 			args: args{
 				client: &test.MockClient{
 					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return errBoom
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"CollectionNotFound": {
+			reason: "We should not return an error if the collection resource was not found.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						return kerrors.NewNotFound(schema.GroupResource{}, "")
+					},
+				},
+			},
+		},
+		"CreateObservedObjects": {
+			reason: "Create observed-only object from the matched objects.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+
+						if key != collectionName {
+							return fmt.Errorf("Expected %v, but got %v", collectionName, key)
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+								Selector: metav1.LabelSelector{
+									MatchLabels: map[string]string{
+										"foo": "bar",
+									},
+								},
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo0"), readyObject("col-foo1"))
+							return nil
+						}
+						if gvk := list.GetObjectKind().GroupVersionKind(); gvk.GroupVersion().String() != "v1" || gvk.Kind != "FooList" {
+							return fmt.Errorf("Unexpected GVK %v", gvk)
+						}
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+							o.SetUID(types.UID(uuid.New().String()))
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if patch != client.Apply { //nolint:staticcheck // SA1019: match the production Patch call site's use of client.Apply
+							return fmt.Errorf("Expected SSA patch, but got: %v", patch)
+						}
+						u := obj.(*unstructured.Unstructured)
+						if u.GroupVersionKind() != v1alpha2.ObjectGroupVersionKind {
+							return fmt.Errorf("Expected gvk %v, but got %v", v1alpha2.ObjectGroupVersionKind, u.GroupVersionKind())
+						}
+						if l := u.GetLabels()[membershipLabelKey]; l != collectionName.Name {
+							return fmt.Errorf("Expecting membership label %v but got %v", collectionName.Name, l)
+						}
+						if s := sets.New[string]("col-foo0", "col-foo1"); !s.Has(u.GetName()) {
+							return fmt.Errorf("Expecting one of %v, but got %v", s.UnsortedList(), u.GetName())
+						}
+						manifest, found, err := unstructured.NestedMap(u.Object, "spec", "forProvider", "manifest")
+						if err != nil {
+							return err
+						}
+						if !found {
+							return fmt.Errorf("Manifest not found")
+						}
+						if apiVersion := manifest["apiVersion"]; apiVersion != objectAPIVersion {
+							return fmt.Errorf("Manifest apiVersion should be %v, but got: %v", objectAPIVersion, apiVersion)
+						}
+						if kind := manifest["kind"]; kind != objectKind {
+							return fmt.Errorf("Manifest kind should be %v, but got: %v", objectKind, kind)
+						}
+						manifestMetadataName, _, _ := unstructured.NestedString(manifest, "metadata", "name")
+						if s := sets.New[string]("foo0", "foo1"); !s.Has(manifestMetadataName) {
+							return fmt.Errorf("Expecting one of %v, but got %v", s.UnsortedList(), manifestMetadataName)
+						}
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if cnd := c.Status.GetCondition(xpv2.TypeSynced); cnd.Status != corev1.ConditionTrue {
+							panic(fmt.Sprintf("Object sync condition not true: %v", cnd.Message))
+						}
+						if cnd := c.Status.GetCondition(xpv2.TypeReady); cnd.Status != corev1.ConditionTrue {
+							panic(fmt.Sprintf("Object ready condition not true: %v", cnd.Message))
+						}
+						if v := c.Status.MembershipLabel[membershipLabelKey]; v != collectionName.Name {
+							panic(fmt.Sprintf("Expected membership label %v but got %v", collectionName.Name, v))
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"FilterObservedObjects": {
+			reason: "Only create observed-only objects for matched objects of the selected owner that satisfy the filter.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion:    objectAPIVersion,
+								Kind:          objectKind,
+								FieldSelector: "spec.nodeName=node-1",
+								Filter:        "object.metadata.name != 'foo2'",
+								OwnerSelector: &v1alpha1.OwnerSelector{Kind: "ReplicaSet", Name: "rs"},
+							},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						lo := &client.ListOptions{}
+						lo.ApplyOptions(opts)
+						if fs := lo.FieldSelector.String(); fs != "spec.nodeName=node-1" {
+							return fmt.Errorf("Expected field selector spec.nodeName=node-1, but got %v", fs)
+						}
+						return matchedItems(list, 3, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+							if i > 0 {
+								o.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs"}})
+							}
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if obj.GetName() != "col-foo1" {
+							return fmt.Errorf("Expected to only create col-foo1, but got %v", obj.GetName())
+						}
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if cnd := c.Status.GetCondition(xpv2.TypeSynced); cnd.Status != corev1.ConditionTrue {
+							return fmt.Errorf("Object sync condition not true: %v", cnd.Message)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"FilterError": {
+			reason: "Members should not be removed if the filter cannot be evaluated against some objects.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+								Filter:     "object.spec.replicas > 1",
+							},
+							ProviderConfigReference: xpv2.Reference{Name: "name"},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo0"), readyObject("col-foo1"))
+							return nil
+						}
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+							if i == 0 {
+								_ = unstructured.SetNestedField(o.(*unstructured.Unstructured).Object, int64(2), "spec", "replicas")
+							}
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if obj.GetName() != "col-foo0" {
+							return fmt.Errorf("Expected to only create col-foo0, but got %v", obj.GetName())
+						}
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						return fmt.Errorf("Expected no member to be removed, but got %v", obj.GetName())
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if cnd := c.Status.GetCondition(xpv2.TypeSynced); cnd.Status != corev1.ConditionFalse || !strings.Contains(cnd.Message, errEvalFilter) {
+							return fmt.Errorf("Expected sync condition reporting the filter error, but got %v", cnd.Message)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"MultipleCriteria": {
+			reason: "Create observed-only objects labelled with the index of the criteria they match.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+							},
+							AdditionalObserveObjects: []v1alpha1.ObserveObjectCriteria{{
+								APIVersion: objectAPIVersion,
+								Kind:       "Bar",
+							}},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName(strings.ToLower(strings.TrimSuffix(list.GetObjectKind().GroupVersionKind().Kind, "List")) + "0")
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						want := map[string]string{"col-foo0": "0", "col-bar0": "1"}[obj.GetName()]
+						if got := obj.GetLabels()[criteriaLabelKey]; got != want {
+							return fmt.Errorf("Expected criteria label %q for %v, but got %q", want, obj.GetName(), got)
+						}
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"TemplateObjectSpec": {
+			reason: "Apply the templated spec to the member Objects, relative to their matched objects.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+							},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+							Template: &v1alpha1.ObservedObjectTemplate{
+								Spec: &v1alpha1.ObservedObjectTemplateSpec{
+									ManagementPolicies: xpv2.ManagementPolicies{xpv2.ManagementActionObserve, xpv2.ManagementActionUpdate},
+									Readiness:          &v1alpha2.Readiness{Policy: v1alpha2.ReadinessPolicyDeriveFromObject},
+									Watch:              true,
+									ConnectionDetails: []v1alpha1.ObservedObjectConnectionDetail{{
+										FieldPath:             "data.password",
+										ToConnectionSecretKey: "password",
+									}},
+									WriteConnectionSecretToReference: &v1alpha1.ObservedObjectSecretReference{
+										NamePattern: "{kind}-{name}-conn",
+										Namespace:   "default",
+									},
+								},
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetNamespace("ns")
+							o.SetName("foo0")
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						o := &v1alpha2.Object{}
+						if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, o); err != nil {
+							return err
+						}
+						want := v1alpha2.ObjectSpec{
+							Readiness: v1alpha2.Readiness{Policy: v1alpha2.ReadinessPolicyDeriveFromObject},
+							Watch:     true,
+							ConnectionDetails: []v1alpha2.ConnectionDetail{{
+								ObjectReference: corev1.ObjectReference{
+									APIVersion: objectAPIVersion,
+									Kind:       objectKind,
+									Namespace:  "ns",
+									Name:       "foo0",
+									FieldPath:  "data.password",
+								},
+								ToConnectionSecretKey: "password",
+							}},
+						}
+						want.ManagementPolicies = xpv2.ManagementPolicies{xpv2.ManagementActionObserve, xpv2.ManagementActionUpdate}
+						want.WriteConnectionSecretToReference = &xpv2.SecretReference{Name: "foo-foo0-conn", Namespace: "default"}
+						got := o.Spec
+						got.ForProvider = v1alpha2.ObjectParameters{}
+						got.ProviderConfigReference = nil
+						if diff := cmp.Diff(want, got); diff != "" {
+							return fmt.Errorf("Unexpected member spec: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"DynamicMetadata": {
+			reason: "Read the dynamic labels and annotations of members from their matched objects, falling back to the static ones.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+							},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+							Template: &v1alpha1.ObservedObjectTemplate{
+								Metadata: v1alpha1.ObservedObjectTemplateMetadata{
+									Labels: map[string]string{"app": "unknown"},
+									DynamicLabels: []v1alpha1.ObservedObjectTemplateValue{
+										{Key: "app", FieldPath: "metadata.labels[app]"},
+										{Key: "node", FieldPath: "spec.nodeName"},
+									},
+									DynamicAnnotations: []v1alpha1.ObservedObjectTemplateValue{
+										{Key: "owner", Expression: "object.metadata.ownerReferences[0].kind + '/' + object.metadata.ownerReferences[0].name"},
+									},
+								},
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						ulist := list.(*unstructured.UnstructuredList)
+						for i := 0; i < 2; i++ {
+							item := unstructured.Unstructured{Object: map[string]any{
+								"spec": map[string]any{"nodeName": fmt.Sprintf("node-%d", i)},
+							}}
+							item.SetKind(ulist.GetKind())
+							item.SetAPIVersion(ulist.GetAPIVersion())
+							item.SetName(fmt.Sprintf("foo%d", i))
+							if i == 0 {
+								item.SetLabels(map[string]string{"app": "web"})
+								item.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs"}})
+							}
+							ulist.Items = append(ulist.Items, item)
+						}
+						return nil
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						want := map[string]struct {
+							labels      map[string]string
+							annotations map[string]string
+						}{
+							"col-foo0": {
+								labels:      map[string]string{"app": "web", "node": "node-0"},
+								annotations: map[string]string{"owner": "ReplicaSet/rs"},
+							},
+							"col-foo1": {
+								labels: map[string]string{"app": "unknown", "node": "node-1"},
+							},
+						}[obj.GetName()]
+						labels := obj.GetLabels()
+						delete(labels, membershipLabelKey)
+						delete(labels, criteriaLabelKey)
+						if diff := cmp.Diff(want.labels, labels); diff != "" {
+							return fmt.Errorf("Unexpected labels of %v: -want, +got:\n%s", obj.GetName(), diff)
+						}
+						annotations := obj.GetAnnotations()
+						delete(annotations, memberHashAnnotationKey)
+						if diff := cmp.Diff(want.annotations, annotations, cmpopts.EquateEmpty()); diff != "" {
+							return fmt.Errorf("Unexpected annotations of %v: -want, +got:\n%s", obj.GetName(), diff)
+						}
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"MembersNotReady": {
+			reason: "Record the members in the status and wait for them to be ready.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+							},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo1"))
+							return nil
+						}
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetNamespace("remote")
+							o.SetName(fmt.Sprintf("foo%d", 1-i))
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						want := v1alpha1.ObservedObjectCollectionStatus{
+							MembershipLabel: map[string]string{membershipLabelKey: collectionName.Name},
+							Members: []v1alpha1.ObservedObjectMember{
+								{APIVersion: objectAPIVersion, Kind: objectKind, Namespace: "remote", Name: "foo0", ObjectName: "col-foo0"},
+								{APIVersion: objectAPIVersion, Kind: objectKind, Namespace: "remote", Name: "foo1", ObjectName: "col-foo1", Ready: true},
+							},
+							MemberCount:      2,
+							ReadyMemberCount: 1,
+						}
+						want.SetConditions(xpv2.ReconcileSuccess(), xpv2.Unavailable().WithMessage("1 of 2 members are ready"), v1alpha1.MembersTracked())
+						if diff := cmp.Diff(want, c.Status, test.EquateConditions()); diff != "" {
+							return fmt.Errorf("-want status, +got status:\n%s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"WatchedCollection": {
+			reason: "Watch the kinds of all criteria of a watched collection and poll at its own interval.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
+							ObserveObjects: v1alpha1.ObserveObjectCriteria{
+								APIVersion: objectAPIVersion,
+								Kind:       objectKind,
+							},
+							AdditionalObserveObjects: []v1alpha1.ObserveObjectCriteria{{
+								APIVersion: "apps/v1",
+								Kind:       "Deployment",
+							}},
+							ProviderConfigReference: xpv2.Reference{
+								Name: "name",
+							},
+							Watch:        true,
+							PollInterval: &metav1.Duration{Duration: time.Hour},
+						}
+						c.Name = collectionName.Name
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
+					},
+				},
+				kindObserver: kindObserverFn(func(rc *rest.Config, providerConfig string, gvks ...schema.GroupVersionKind) {
+					want := []schema.GroupVersionKind{
+						{Version: objectAPIVersion, Kind: objectKind},
+						{Group: "apps", Version: "v1", Kind: "Deployment"},
+					}
+					if diff := cmp.Diff(want, gvks); diff != "" {
+						panic(fmt.Sprintf("WatchResources(...): -want gvks, +got gvks:\n%s", diff))
+					}
+					if pc := "name"; providerConfig != pc {
+						panic(fmt.Sprintf("WatchResources(...): want provider config %s, got %s", pc, providerConfig))
+					}
+				}),
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: time.Hour},
+			},
+		},
+		"PaginatedListing": {
+			reason: "List only the metadata of matched objects, a page at a time.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						unchanged.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						l, ok := list.(*metav1.PartialObjectMetadataList)
+						if !ok {
+							return fmt.Errorf("Expected a metadata-only list, but got %T", list)
+						}
+						lo := &client.ListOptions{}
+						lo.ApplyOptions(opts)
+						if lo.Limit != listPageSize {
+							return fmt.Errorf("Expected page size %d, but got %d", listPageSize, lo.Limit)
+						}
+						if lo.Continue == "" {
+							l.Continue = "page-2"
+							return matchedItems(list, 2, func(i int, o metav1.Object) {
+								o.SetName(fmt.Sprintf("foo%d", i))
+							})
+						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName("foo2")
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if c.Status.MemberCount != 3 {
+							return fmt.Errorf("Expected 3 members, but got %d", c.Status.MemberCount)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"NamespaceSelector": {
+			reason: "Objects should only be listed in the namespaces of the remote cluster matching the namespace selector.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						unchanged.DeepCopyInto(c)
+						c.Spec.ObserveObjects.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
+						}
+						lo := &client.ListOptions{}
+						lo.ApplyOptions(opts)
+						if l, ok := list.(*metav1.PartialObjectMetadataList); ok && l.GetObjectKind().GroupVersionKind().Kind == "NamespaceList" {
+							if lo.LabelSelector.String() != "env=prod" {
+								return fmt.Errorf("Unexpected namespace selector %v", lo.LabelSelector)
+							}
+							for _, ns := range []string{"prod-a", "prod-b"} {
+								l.Items = append(l.Items, metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+							}
+							return nil
+						}
+						if lo.Namespace == "" {
+							return errors.New("Expected objects to be listed in selected namespaces only")
+						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName("foo-" + lo.Namespace)
+							o.SetNamespace(lo.Namespace)
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if c.Status.MemberCount != 2 {
+							return fmt.Errorf("Expected 2 members, but got %d", c.Status.MemberCount)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"SkipUnchangedMembers": {
+			reason: "Members whose patch did not change should not be patched again.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						unchanged.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						matched := unstructured.Unstructured{}
+						matched.SetAPIVersion(objectAPIVersion)
+						matched.SetKind(objectKind)
+						matched.SetName("foo0")
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								return nil
+							}
+							po, err := observedObjectPatch("col-foo0", 0, matched, unchanged, map[string]string{}, nil)
+							if err != nil {
+								return err
+							}
+							o := readyObject("col-foo0")
+							o.SetAnnotations(po.GetAnnotations())
+							olist.Items = append(olist.Items, o)
+							return nil
+						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName("foo0")
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return fmt.Errorf("Unexpected patch of %v", obj.GetName())
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						return nil
 					},
 				},
 			},
 			want: want{
-				err: errBoom,
+				r: reconcile.Result{RequeueAfter: pollIterval},
 			},
 		},
-		"CollectionNotFound": {
-			reason: "We should not return an error if the collection resource was not found.",
+		"MaxMembersExceeded": {
+			reason: "A collection matching more objects than its maximum number of members should be degraded, and its members left as they are.",
 			args: args{
 				client: &test.MockClient{
 					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						return kerrors.NewNotFound(schema.GroupResource{}, "")
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
+						}
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						unchanged.DeepCopyInto(c)
+						c.Spec.MaxMembers = ptr.To[int64](1)
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo0"), readyObject("col-bar"))
+							return nil
+						}
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return fmt.Errorf("Unexpected patch of %v", obj.GetName())
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						return fmt.Errorf("Unexpected deletion of %v", obj.GetName())
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if cnd := c.Status.GetCondition(v1alpha1.TypeDegraded); cnd.Reason != v1alpha1.ReasonMaxMembersExceeded {
+							return fmt.Errorf("Expected the collection to be degraded, but got %v", cnd)
+						}
+						if cnd := c.Status.GetCondition(xpv2.TypeSynced); cnd.Status != corev1.ConditionFalse {
+							return fmt.Errorf("Expected the collection not to be synced, but got %v", cnd)
+						}
+						return nil
 					},
 				},
 			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
 		},
-		"CreateObservedObjects": {
-			reason: "Create observed-only object from the matched objects.",
+		"DeleteMembers": {
+			reason: "A deleted collection should delete its members and report how many are left.",
 			args: args{
 				client: &test.MockClient{
 					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
-						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
-							return nil
+						deleted.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						olist := list.(*v1alpha2.ObjectList)
+						gone := member("col-foo1")
+						gone.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+						olist.Items = append(olist.Items, member("col-foo0"), gone)
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						if obj.GetName() != "col-foo0" {
+							return fmt.Errorf("Unexpected deletion of %v", obj.GetName())
 						}
-
-						if key != collectionName {
-							return fmt.Errorf("Expected %v, but got %v", collectionName, key)
+						return nil
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						want := xpv2.Deleting().WithMessage("waiting for 2 members to be deleted")
+						if cnd := c.Status.GetCondition(xpv2.TypeReady); !cnd.Equal(want) {
+							return fmt.Errorf("Expected condition %v, but got %v", want, cnd)
 						}
+						return nil
+					},
+				},
+				finalizer: resource.FinalizerFns{
+					RemoveFinalizerFn: func(ctx context.Context, obj resource.Object) error {
+						return fmt.Errorf("Unexpected removal of the finalizer")
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: memberDeletionPollInterval},
+			},
+		},
+		"MembersDeleted": {
+			reason: "A deleted collection without members should remove its finalizer.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						deleted.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						return nil
+					},
+				},
+				finalizer: resource.FinalizerFns{
+					RemoveFinalizerFn: func(ctx context.Context, obj resource.Object) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"OrphanMembers": {
+			reason: "A deleted collection should remove its owner reference and membership labels from its members before removing its finalizer.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
 						c := obj.(*v1alpha1.ObservedObjectCollection)
-						c.Spec = v1alpha1.ObservedObjectCollectionSpec{
-							ObserveObjects: v1alpha1.ObserveObjectCriteria{
-								APIVersion: objectAPIVersion,
-								Kind:       objectKind,
-								Selector: metav1.LabelSelector{
-									MatchLabels: map[string]string{
-										"foo": "bar",
-									},
-								},
-							},
+						deleted.DeepCopyInto(c)
+						c.Spec.MemberDeletionPolicy = v1alpha1.MemberDeletionOrphan
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						olist := list.(*v1alpha2.ObjectList)
+						olist.Items = append(olist.Items, member("col-foo0"))
+						return nil
+					},
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						if diff := cmp.Diff(map[string]string{"app": "web"}, obj.GetLabels()); diff != "" {
+							return fmt.Errorf("Unexpected labels: -want, +got:\n%s", diff)
 						}
-						c.Name = collectionName.Name
+						if diff := cmp.Diff(map[string]string{}, obj.GetAnnotations()); diff != "" {
+							return fmt.Errorf("Unexpected annotations: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]metav1.OwnerReference{{Name: "other", UID: "other-uid"}}, obj.GetOwnerReferences()); diff != "" {
+							return fmt.Errorf("Unexpected owner references: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						return fmt.Errorf("Unexpected deletion of %v", obj.GetName())
+					},
+				},
+				finalizer: resource.FinalizerFns{
+					RemoveFinalizerFn: func(ctx context.Context, obj resource.Object) error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{},
+			},
+		},
+		"AdoptAnnotatedMember": {
+			reason: "A member annotated for adoption should be fully managed with the cleaned state of its matched object, and leave the collection.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *apisv1alpha1.ProviderConfig:
+							return nil
+						case *unstructured.Unstructured:
+							o.SetResourceVersion("42")
+							o.SetUID("remote-uid")
+							o.SetName(key.Name)
+							o.Object["data"] = map[string]any{"key": "value"}
+							o.Object["status"] = map[string]any{"phase": "Active"}
+							return nil
+						}
+						unchanged.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
 						return nil
 					},
 					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
 						if olist, ok := list.(*v1alpha2.ObjectList); ok {
-							olist.Items = append(olist.Items, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo0"}}, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo1"}})
+							if adoptedList(opts) {
+								return nil
+							}
+							o := readyObject("col-foo0")
+							o.SetLabels(map[string]string{membershipLabelKey: collectionName.Name, criteriaLabelKey: "0"})
+							o.SetAnnotations(map[string]string{adoptAnnotationKey: "true"})
+							olist.Items = append(olist.Items, o, readyObject("col-foo1"))
 							return nil
 						}
-						ulist := list.(*unstructured.UnstructuredList)
-						if ulist.GetAPIVersion() != "v1" || ulist.GetKind() != "Foo" {
-							return fmt.Errorf("Unexpected GVK %v", ulist.GroupVersionKind())
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+						})
+					},
+					MockUpdate: func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+						o := obj.(*v1alpha2.Object)
+						if o.GetName() != "col-foo0" {
+							return fmt.Errorf("Unexpected update of %v", o.GetName())
 						}
-						for i := 0; i < 2; i++ {
-							item := unstructured.Unstructured{}
-							item.SetKind(ulist.GetKind())
-							item.SetAPIVersion(ulist.GetAPIVersion())
-							item.SetName(fmt.Sprintf("foo%d", i))
-							item.SetUID(types.UID(uuid.New().String()))
-							ulist.Items = append(ulist.Items, item)
+						if diff := cmp.Diff(map[string]string{adoptedLabelKey: collectionName.Name}, o.GetLabels()); diff != "" {
+							return fmt.Errorf("Unexpected labels: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(xpv2.ManagementPolicies{xpv2.ManagementActionAll}, o.GetManagementPolicies()); diff != "" {
+							return fmt.Errorf("Unexpected management policies: -want, +got:\n%s", diff)
+						}
+						want := `{"apiVersion":"v1","data":{"key":"value"},"kind":"Foo","metadata":{"name":"foo0"}}`
+						if diff := cmp.Diff(want, string(o.Spec.ForProvider.Manifest.Raw)); diff != "" {
+							return fmt.Errorf("Unexpected manifest: -want, +got:\n%s", diff)
 						}
 						return nil
 					},
 					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
-						if patch != client.Apply { //nolint:staticcheck // SA1019: match the production Patch call site's use of client.Apply
-							return fmt.Errorf("Expected SSA patch, but got: %v", patch)
-						}
-						u := obj.(*unstructured.Unstructured)
-						if u.GroupVersionKind() != v1alpha2.ObjectGroupVersionKind {
-							return fmt.Errorf("Expected gvk %v, but got %v", v1alpha2.ObjectGroupVersionKind, u.GroupVersionKind())
-						}
-						if l := u.GetLabels()[membershipLabelKey]; l != collectionName.Name {
-							return fmt.Errorf("Expecting membership label %v but got %v", collectionName.Name, l)
+						if obj.GetName() != "col-foo1" {
+							return fmt.Errorf("Unexpected patch of %v", obj.GetName())
 						}
-						if s := sets.New[string]("col-foo0", "col-foo1"); !s.Has(u.GetName()) {
-							return fmt.Errorf("Expecting one of %v, but got %v", s.UnsortedList(), u.GetName())
-						}
-						manifest, found, err := unstructured.NestedMap(u.Object, "spec", "forProvider", "manifest")
-						if err != nil {
-							return err
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						return fmt.Errorf("Unexpected deletion of %v", obj.GetName())
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						if c.Status.MemberCount != 1 {
+							return fmt.Errorf("Expected 1 member, but got %d", c.Status.MemberCount)
 						}
-						if !found {
-							return fmt.Errorf("Manifest not found")
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"AdoptMembers": {
+			reason: "Matched objects of a collection adopting its members should be created as fully managed Objects that are not members.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						switch o := obj.(type) {
+						case *apisv1alpha1.ProviderConfig:
+							return nil
+						case *unstructured.Unstructured:
+							o.SetName(key.Name)
+							return nil
 						}
-						if apiVersion := manifest["apiVersion"]; apiVersion != objectAPIVersion {
-							return fmt.Errorf("Manifest apiVersion should be %v, but got: %v", objectAPIVersion, apiVersion)
+						c := obj.(*v1alpha1.ObservedObjectCollection)
+						unchanged.DeepCopyInto(c)
+						c.Spec.AdoptMembers = true
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := list.(*v1alpha2.ObjectList); ok {
+							return nil
 						}
-						if kind := manifest["kind"]; kind != objectKind {
-							return fmt.Errorf("Manifest kind should be %v, but got: %v", objectKind, kind)
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName("foo0")
+						})
+					},
+					MockCreate: func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+						o := obj.(*v1alpha2.Object)
+						if diff := cmp.Diff(map[string]string{adoptedLabelKey: collectionName.Name}, o.GetLabels()); diff != "" {
+							return fmt.Errorf("Unexpected labels: -want, +got:\n%s", diff)
 						}
-						manifestMetadataName, _, _ := unstructured.NestedString(manifest, "metadata", "name")
-						if s := sets.New[string]("foo0", "foo1"); !s.Has(manifestMetadataName) {
-							return fmt.Errorf("Expecting one of %v, but got %v", s.UnsortedList(), manifestMetadataName)
+						if len(o.GetOwnerReferences()) > 0 {
+							return fmt.Errorf("Unexpected owner references %v", o.GetOwnerReferences())
 						}
 						return nil
 					},
 					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
 						c := obj.(*v1alpha1.ObservedObjectCollection)
-						if cnd := c.Status.GetCondition(xpv2.TypeSynced); cnd.Status != corev1.ConditionTrue {
-							panic(fmt.Sprintf("Object sync condition not true: %v", cnd.Message))
+						if c.Status.MemberCount != 0 {
+							return fmt.Errorf("Expected no members, but got %d", c.Status.MemberCount)
 						}
-						if cnd := c.Status.GetCondition(xpv2.TypeReady); cnd.Status != corev1.ConditionTrue {
-							panic(fmt.Sprintf("Object ready condition not true: %v", cnd.Message))
+						return nil
+					},
+				},
+			},
+			want: want{
+				r: reconcile.Result{RequeueAfter: pollIterval},
+			},
+		},
+		"SkipAdoptedObjects": {
+			reason: "Objects adopted from a collection should neither be patched nor deleted.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if _, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+							return nil
 						}
-						if v := c.Status.MembershipLabel[membershipLabelKey]; v != collectionName.Name {
-							panic(fmt.Sprintf("Expected membership label %v but got %v", collectionName.Name, v))
+						unchanged.DeepCopyInto(obj.(*v1alpha1.ObservedObjectCollection))
+						return nil
+					},
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						if olist, ok := list.(*v1alpha2.ObjectList); ok {
+							if adoptedList(opts) {
+								olist.Items = append(olist.Items, readyObject("col-foo0"))
+							}
+							return nil
 						}
+						return matchedItems(list, 1, func(_ int, o metav1.Object) {
+							o.SetName("foo0")
+						})
+					},
+					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						return fmt.Errorf("Unexpected patch of %v", obj.GetName())
+					},
+					MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
 						return nil
 					},
 				},
@@ -216,22 +1193,19 @@ func TestReconciler(t *testing.T) {
 					},
 					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
 						if olist, ok := list.(*v1alpha2.ObjectList); ok {
-							olist.Items = append(olist.Items, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo0"}}, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo1"}}, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo2"}})
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo0"), readyObject("col-foo1"), readyObject("col-foo2"))
 							return nil
 						}
-						ulist := list.(*unstructured.UnstructuredList)
-						if ulist.GetAPIVersion() != "v1" || ulist.GetKind() != "Foo" {
-							return fmt.Errorf("Unexpected GVK %v", ulist.GroupVersionKind())
-						}
-						for i := 0; i < 2; i++ {
-							item := unstructured.Unstructured{}
-							item.SetKind(ulist.GetKind())
-							item.SetAPIVersion(ulist.GetAPIVersion())
-							item.SetName(fmt.Sprintf("foo%d", i))
-							item.SetUID(types.UID(uuid.New().String()))
-							ulist.Items = append(ulist.Items, item)
+						if gvk := list.GetObjectKind().GroupVersionKind(); gvk.GroupVersion().String() != "v1" || gvk.Kind != "FooList" {
+							return fmt.Errorf("Unexpected GVK %v", gvk)
 						}
-						return nil
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+							o.SetUID(types.UID(uuid.New().String()))
+						})
 					},
 					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
 						if obj.GetName() != "col-foo2" {
@@ -361,22 +1335,19 @@ func TestReconciler(t *testing.T) {
 					},
 					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
 						if olist, ok := list.(*v1alpha2.ObjectList); ok {
-							olist.Items = append(olist.Items, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo0"}}, v1alpha2.Object{ObjectMeta: metav1.ObjectMeta{Name: "col-foo1"}})
+							if adoptedList(opts) {
+								return nil
+							}
+							olist.Items = append(olist.Items, readyObject("col-foo0"), readyObject("col-foo1"))
 							return nil
 						}
-						ulist := list.(*unstructured.UnstructuredList)
-						if ulist.GetAPIVersion() != "v1" || ulist.GetKind() != "Foo" {
-							return fmt.Errorf("Unexpected GVK %v", ulist.GroupVersionKind())
-						}
-						for i := 0; i < 2; i++ {
-							item := unstructured.Unstructured{}
-							item.SetKind(ulist.GetKind())
-							item.SetAPIVersion(ulist.GetAPIVersion())
-							item.SetName(fmt.Sprintf("foo%d", i))
-							item.SetUID(types.UID(uuid.New().String()))
-							ulist.Items = append(ulist.Items, item)
+						if gvk := list.GetObjectKind().GroupVersionKind(); gvk.GroupVersion().String() != "v1" || gvk.Kind != "FooList" {
+							return fmt.Errorf("Unexpected GVK %v", gvk)
 						}
-						return nil
+						return matchedItems(list, 2, func(i int, o metav1.Object) {
+							o.SetName(fmt.Sprintf("foo%d", i))
+							o.SetUID(types.UID(uuid.New().String()))
+						})
 					},
 					MockPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return errBoom
@@ -397,13 +1368,23 @@ func TestReconciler(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			f := tc.args.finalizer
+			if f == nil {
+				f = resource.FinalizerFns{
+					AddFinalizerFn:    func(ctx context.Context, obj resource.Object) error { return nil },
+					RemoveFinalizerFn: func(ctx context.Context, obj resource.Object) error { return nil },
+				}
+			}
 			r := &Reconciler{
-				client: tc.args.client,
-				log:    logging.NewNopLogger(),
+				client:    tc.args.client,
+				log:       logging.NewNopLogger(),
+				record:    event.NewNopRecorder(),
+				finalizer: f,
 				clientBuilder: kubeclient.BuilderFn(func(ctx context.Context, pc kconfig.ProviderConfigSpec) (client.Client, *rest.Config, error) {
 					return tc.args.client, nil, nil
 				}),
-				observedObjectName: func(collection client.Object, matchedObject client.Object) (string, error) {
+				kindObserver: tc.args.kindObserver,
+				observedObjectName: func(collection *v1alpha1.ObservedObjectCollection, matchedObject client.Object) (string, error) {
 					return fmt.Sprintf("%s-%s", collection.GetName(), matchedObject.GetName()), nil
 				},
 				pollInterval: func() time.Duration {
@@ -420,3 +1401,60 @@ func TestReconciler(t *testing.T) {
 		})
 	}
 }
+
+func TestObservedObjectName(t *testing.T) {
+	collection := func(name string) *v1alpha1.ObservedObjectCollection {
+		c := &v1alpha1.ObservedObjectCollection{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		c.Spec.ObserveObjects = v1alpha1.ObserveObjectCriteria{APIVersion: "v1", Kind: "ConfigMap"}
+		return c
+	}
+	object := func(kind string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind(kind)
+		u.SetNamespace("default")
+		u.SetName("foo")
+		return u
+	}
+
+	type want struct {
+		name string
+		err  error
+	}
+	cases := map[string]struct {
+		reason     string
+		collection *v1alpha1.ObservedObjectCollection
+		object     *unstructured.Unstructured
+		want       want
+	}{
+		"FirstCriteriaKind": {
+			reason:     "Objects of the kind of the first criteria should keep the name they had before collections observed multiple kinds.",
+			collection: collection("col"),
+			object:     object("ConfigMap"),
+			want:       want{name: "col-436a6a3"},
+		},
+		"OtherKind": {
+			reason:     "Objects of other kinds should be told apart by their kind.",
+			collection: collection("col"),
+			object:     object("Secret"),
+			want:       want{name: "col-secret-e4fe84c"},
+		},
+		"LongCollectionName": {
+			reason:     "The collection name should be truncated so that the name stays a valid object name.",
+			collection: collection(strings.Repeat("a", 237) + "-" + strings.Repeat("b", 15)),
+			object:     object("Secret"),
+			want:       want{name: strings.Repeat("a", 237) + "-secret-e4fe84c"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := observedObjectName(tc.collection, tc.object)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nobservedObjectName(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("\n%s\nobservedObjectName(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
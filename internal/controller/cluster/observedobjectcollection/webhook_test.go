@@ -0,0 +1,177 @@
+package observedobjectcollection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/object/v1alpha2"
+	"github.com/crossplane-contrib/provider-kubernetes/apis/cluster/observedobjectcollection/v1alpha1"
+)
+
+func TestValidateCollection(t *testing.T) {
+	collection := func(m ...func(c *v1alpha1.ObservedObjectCollection)) *v1alpha1.ObservedObjectCollection {
+		c := &v1alpha1.ObservedObjectCollection{
+			ObjectMeta: metav1.ObjectMeta{Name: "col"},
+			Spec: v1alpha1.ObservedObjectCollectionSpec{
+				ObserveObjects: v1alpha1.ObserveObjectCriteria{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+		}
+		for _, f := range m {
+			f(c)
+		}
+		return c
+	}
+	cases := map[string]struct {
+		reason string
+		c      *v1alpha1.ObservedObjectCollection
+		want   []string
+	}{
+		"Valid": {
+			reason: "A well-formed collection should be admitted.",
+			c:      collection(),
+		},
+		"InvalidAPIVersion": {
+			reason: "An apiVersion that cannot be parsed should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.APIVersion = "a/b/c"
+			}),
+			want: []string{"FieldValueInvalid: spec.observeObjects.apiVersion"},
+		},
+		"NamespaceOnClusterScopedKind": {
+			reason: "A namespace for a well-known cluster-scoped kind should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.Kind = "Namespace"
+				c.Spec.ObserveObjects.Namespace = "default"
+			}),
+			want: []string{"FieldValueForbidden: spec.observeObjects.namespace"},
+		},
+		"InvalidSelector": {
+			reason: "A selector the controller cannot convert should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "foo", Operator: metav1.LabelSelectorOpIn}}
+			}),
+			want: []string{"FieldValueRequired: spec.observeObjects.selector.matchExpressions[0].values"},
+		},
+		"NamespaceAndNamespaceSelector": {
+			reason: "A namespace selector should be rejected together with a namespace.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.Namespace = "default"
+				c.Spec.ObserveObjects.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+			}),
+			want: []string{"FieldValueForbidden: spec.observeObjects.namespaceSelector"},
+		},
+		"InvalidNamespaceSelector": {
+			reason: "A namespace selector the controller cannot convert should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpIn}}}
+			}),
+			want: []string{"FieldValueRequired: spec.observeObjects.namespaceSelector.matchExpressions[0].values"},
+		},
+		"InvalidFieldSelector": {
+			reason: "A field selector that cannot be parsed should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.FieldSelector = "spec.nodeName"
+			}),
+			want: []string{"FieldValueInvalid: spec.observeObjects.fieldSelector"},
+		},
+		"InvalidFilter": {
+			reason: "A filter that does not evaluate to a bool should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.ObserveObjects.Filter = "object.metadata.name"
+			}),
+			want: []string{"FieldValueInvalid: spec.observeObjects.filter"},
+		},
+		"InvalidAdditionalCriteria": {
+			reason: "Additional criteria should be validated like the first criteria.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.AdditionalObserveObjects = []v1alpha1.ObserveObjectCriteria{
+					{APIVersion: "v1", Kind: "Secret"},
+					{APIVersion: "v1", Kind: "Service", FieldSelector: "spec.type"},
+				}
+			}),
+			want: []string{"FieldValueInvalid: spec.additionalObserveObjects[1].fieldSelector"},
+		},
+		"InvalidPollInterval": {
+			reason: "A poll interval that is not positive should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.PollInterval = &metav1.Duration{}
+			}),
+			want: []string{"FieldValueInvalid: spec.pollInterval"},
+		},
+		"InvalidTemplateLabels": {
+			reason: "Template labels that are not valid label values should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.Template = &v1alpha1.ObservedObjectTemplate{
+					Metadata: v1alpha1.ObservedObjectTemplateMetadata{Labels: map[string]string{"foo": "not a value"}},
+				}
+			}),
+			want: []string{"FieldValueInvalid: spec.objectTemplate.metadata.labels"},
+		},
+		"InvalidDynamicLabels": {
+			reason: "Dynamic labels with an invalid key, field path or expression should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.Template = &v1alpha1.ObservedObjectTemplate{
+					Metadata: v1alpha1.ObservedObjectTemplateMetadata{DynamicLabels: []v1alpha1.ObservedObjectTemplateValue{
+						{Key: "not a key", FieldPath: "metadata.name"},
+						{Key: "node", FieldPath: "spec[nodeName"},
+						{Key: "owner", Expression: "object.metadata.ownerReferences.map(r, r.name)"},
+						{Key: "app"},
+					}},
+				}
+			}),
+			want: []string{
+				"FieldValueInvalid: spec.objectTemplate.metadata.dynamicLabels[0].key",
+				"FieldValueInvalid: spec.objectTemplate.metadata.dynamicLabels[1].fieldPath",
+				"FieldValueInvalid: spec.objectTemplate.metadata.dynamicLabels[2].expression",
+				"FieldValueInvalid: spec.objectTemplate.metadata.dynamicLabels[3]",
+			},
+		},
+		"InvalidReadinessQuery": {
+			reason: "A readiness CEL query that does not compile should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.Template = &v1alpha1.ObservedObjectTemplate{
+					Spec: &v1alpha1.ObservedObjectTemplateSpec{
+						Readiness: &v1alpha2.Readiness{Policy: v1alpha2.ReadinessPolicyDeriveFromCelQuery, CelQuery: "object.status"},
+					},
+				}
+			}),
+			want: []string{"FieldValueInvalid: spec.objectTemplate.spec.readiness.celQuery"},
+		},
+		"InvalidConnectionSecretNamePattern": {
+			reason: "A connection secret name pattern that cannot yield a valid name should be rejected.",
+			c: collection(func(c *v1alpha1.ObservedObjectCollection) {
+				c.Spec.Template = &v1alpha1.ObservedObjectTemplate{
+					Spec: &v1alpha1.ObservedObjectTemplateSpec{
+						WriteConnectionSecretToReference: &v1alpha1.ObservedObjectSecretReference{NamePattern: "{name}_Conn"},
+					},
+				}
+			}),
+			want: []string{"FieldValueInvalid: spec.objectTemplate.spec.writeConnectionSecretToRef.namePattern"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&validator{}).ValidateCreate(context.Background(), tc.c)
+			var got []string
+			if se, ok := err.(*kerrors.StatusError); ok {
+				for _, c := range se.ErrStatus.Details.Causes {
+					got = append(got, string(c.Type)+": "+c.Field)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidateCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// lastAppliedConfigAnnotation is the annotation kubectl records the last
+// applied configuration of an object in.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// serverMetadataFields are the metadata fields of an object that are set by
+// the API server or by controllers rather than by its author.
+var serverMetadataFields = []string{
+	"uid",
+	"resourceVersion",
+	"generation",
+	"generateName",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+	"managedFields",
+	"selfLink",
+	"ownerReferences",
+	"finalizers",
+}
+
+// CleanManifest returns a copy of the supplied object without its status and
+// the metadata set by the API server, so that it can be used as the manifest
+// of an Object that manages it.
+func CleanManifest(o *unstructured.Unstructured) *unstructured.Unstructured {
+	m := o.DeepCopy()
+	unstructured.RemoveNestedField(m.Object, "status")
+	for _, f := range serverMetadataFields {
+		unstructured.RemoveNestedField(m.Object, "metadata", f)
+	}
+	a := m.GetAnnotations()
+	delete(a, lastAppliedConfigAnnotation)
+	if len(a) == 0 {
+		a = nil
+	}
+	m.SetAnnotations(a)
+	return m
+}
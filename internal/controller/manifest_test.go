@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCleanManifest(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      map[string]any
+		want   map[string]any
+	}{
+		"ServerFields": {
+			reason: "The status and the metadata set by the API server should be removed.",
+			o: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name":              "foo",
+					"namespace":         "default",
+					"uid":               "uid",
+					"resourceVersion":   "1",
+					"creationTimestamp": "2026-01-01T00:00:00Z",
+					"managedFields":     []any{map[string]any{"manager": "kubectl"}},
+					"ownerReferences":   []any{map[string]any{"name": "owner"}},
+					"labels":            map[string]any{"app": "web"},
+					"annotations": map[string]any{
+						lastAppliedConfigAnnotation: "{}",
+						"note":                      "kept",
+					},
+				},
+				"data":   map[string]any{"key": "value"},
+				"status": map[string]any{"phase": "Active"},
+			},
+			want: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name":        "foo",
+					"namespace":   "default",
+					"labels":      map[string]any{"app": "web"},
+					"annotations": map[string]any{"note": "kept"},
+				},
+				"data": map[string]any{"key": "value"},
+			},
+		},
+		"OnlyLastAppliedConfig": {
+			reason: "The annotations should be removed if only the last applied configuration was recorded.",
+			o: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name":        "foo",
+					"annotations": map[string]any{lastAppliedConfigAnnotation: "{}"},
+				},
+			},
+			want: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name": "foo",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &unstructured.Unstructured{Object: tc.o}
+			before := o.DeepCopy()
+			got := CleanManifest(o)
+			if diff := cmp.Diff(tc.want, got.Object); diff != "" {
+				t.Errorf("\n%s\nCleanManifest(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(before, o); diff != "" {
+				t.Errorf("\n%s\nCleanManifest(...): the supplied object should not be modified: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ExternalDeletions counts remote objects that were deleted by someone other
+// than this provider, by the kind and namespace of the Object that manages
+// them and the external deletion policy that was applied. The Object itself
+// is named by the warning event recorded for it.
+var ExternalDeletions = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "provider_kubernetes",
+	Name:      "external_deletions_total",
+	Help:      "The number of remote objects deleted by someone other than provider-kubernetes.",
+}, []string{"gvk", "namespace", "policy"})
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-kubernetes-m-crossplane-io-v1alpha1-providerconfig,mutating=false,failurePolicy=fail,groups=kubernetes.m.crossplane.io,resources=providerconfigs,versions=v1alpha1,name=providerconfigs.kubernetes.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+// +kubebuilder:webhook:verbs=create;update,path=/validate-kubernetes-m-crossplane-io-v1alpha1-clusterproviderconfig,mutating=false,failurePolicy=fail,groups=kubernetes.m.crossplane.io,resources=clusterproviderconfigs,versions=v1alpha1,name=clusterproviderconfigs.kubernetes.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// SetupWebhook adds validating webhooks for ProviderConfigs and
+// ClusterProviderConfigs.
+func SetupWebhook(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr, &v1alpha1.ProviderConfig{}).
+		WithValidator(&providerConfigValidator{}).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.ClusterProviderConfig{}).
+		WithValidator(&clusterProviderConfigValidator{}).
+		Complete()
+}
+
+// providerConfigValidator rejects ProviderConfigs whose validation rules do
+// not compile.
+type providerConfigValidator struct{}
+
+var _ admission.Validator[*v1alpha1.ProviderConfig] = &providerConfigValidator{}
+
+// ValidateCreate validates a new ProviderConfig.
+func (v *providerConfigValidator) ValidateCreate(_ context.Context, pc *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	return nil, invalid(v1alpha1.ProviderConfigKind, pc.GetName(), validateSpec(&pc.Spec))
+}
+
+// ValidateUpdate validates a ProviderConfig whose spec changed.
+func (v *providerConfigValidator) ValidateUpdate(_ context.Context, oldPC, newPC *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	if meta.WasDeleted(newPC) || equality.Semantic.DeepEqual(oldPC.Spec, newPC.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(v1alpha1.ProviderConfigKind, newPC.GetName(), validateSpec(&newPC.Spec))
+}
+
+// ValidateDelete admits every deletion.
+func (v *providerConfigValidator) ValidateDelete(_ context.Context, _ *v1alpha1.ProviderConfig) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// clusterProviderConfigValidator rejects ClusterProviderConfigs whose
+// validation rules do not compile.
+type clusterProviderConfigValidator struct{}
+
+var _ admission.Validator[*v1alpha1.ClusterProviderConfig] = &clusterProviderConfigValidator{}
+
+// ValidateCreate validates a new ClusterProviderConfig.
+func (v *clusterProviderConfigValidator) ValidateCreate(_ context.Context, pc *v1alpha1.ClusterProviderConfig) (admission.Warnings, error) {
+	return nil, invalid(v1alpha1.ClusterProviderConfigKind, pc.GetName(), validateSpec(&pc.Spec.ProviderConfigSpec))
+}
+
+// ValidateUpdate validates a ClusterProviderConfig whose spec changed.
+func (v *clusterProviderConfigValidator) ValidateUpdate(_ context.Context, oldPC, newPC *v1alpha1.ClusterProviderConfig) (admission.Warnings, error) {
+	if meta.WasDeleted(newPC) || equality.Semantic.DeepEqual(oldPC.Spec, newPC.Spec) {
+		return nil, nil
+	}
+	return nil, invalid(v1alpha1.ClusterProviderConfigKind, newPC.GetName(), validateSpec(&newPC.Spec.ProviderConfigSpec))
+}
+
+// ValidateDelete admits every deletion.
+func (v *clusterProviderConfigValidator) ValidateDelete(_ context.Context, _ *v1alpha1.ClusterProviderConfig) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func invalid(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(schema.GroupKind{Group: v1alpha1.Group, Kind: kind}, name, errs)
+}
+
+// validateSpec runs the structural checks of the policies of the supplied
+// provider config spec.
+func validateSpec(spec *kconfig.ProviderConfigSpec) field.ErrorList {
+	return pcontroller.ValidateValidationRules(spec.ValidationRules, field.NewPath("spec", "validationRules"))
+}
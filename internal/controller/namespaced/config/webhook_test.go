@@ -0,0 +1,70 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+// invalidFields returns the "type: field" pairs of an Invalid status error.
+func invalidFields(err error) []string {
+	if err == nil {
+		return nil
+	}
+	se, ok := err.(*kerrors.StatusError)
+	if !ok || se.ErrStatus.Details == nil {
+		return []string{err.Error()}
+	}
+	out := make([]string, 0, len(se.ErrStatus.Details.Causes))
+	for _, c := range se.ErrStatus.Details.Causes {
+		out = append(out, string(c.Type)+": "+c.Field)
+	}
+	return out
+}
+
+func TestValidateCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		rules  []kconfig.ValidationRule
+		want   []string
+	}{
+		"NoRules": {
+			reason: "A provider config without validation rules should be admitted.",
+		},
+		"ValidRule": {
+			reason: "A provider config whose validation rules compile should be admitted.",
+			rules:  []kconfig.ValidationRule{{Name: "replicas", Rule: "object.spec.replicas <= 3"}},
+		},
+		"InvalidRule": {
+			reason: "A provider config with a validation rule that does not compile should be rejected.",
+			rules: []kconfig.ValidationRule{
+				{Name: "replicas", Rule: "object.spec.replicas <= 3"},
+				{Name: "broken", Rule: "object.spec.replicas <="},
+			},
+			want: []string{"FieldValueInvalid: spec.validationRules[1].rule"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1alpha1.ProviderConfig{}
+			pc.Spec.ValidationRules = tc.rules
+			_, err := (&providerConfigValidator{}).ValidateCreate(context.Background(), pc)
+			if diff := cmp.Diff(tc.want, invalidFields(err), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nproviderConfigValidator.ValidateCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			cpc := &v1alpha1.ClusterProviderConfig{}
+			cpc.Spec.ValidationRules = tc.rules
+			_, err = (&clusterProviderConfigValidator{}).ValidateCreate(context.Background(), cpc)
+			if diff := cmp.Diff(tc.want, invalidFields(err), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nclusterProviderConfigValidator.ValidateCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/extractor"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/state"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const errReferencesNeedLocalClient = "cannot resolve references without access to the control plane"
+
+// A RemoteState is the state of the remote object of an Object, as computed
+// by a ResourceSyncer when observing the Object.
+type RemoteState struct {
+	// Exists is true if the remote object exists.
+	Exists bool
+
+	// Observed is the observed state of the remote object. It is nil if the
+	// remote object does not exist, or if the syncer cannot tell which of its
+	// fields were applied by the provider.
+	Observed *unstructured.Unstructured
+
+	// Desired is the state the remote object would have once synced. It is
+	// nil if the field managers of the remote object need to be upgraded
+	// before the desired state can be computed.
+	Desired *unstructured.Unstructured
+}
+
+// UpToDate returns true if syncing the Object would not change its remote
+// object.
+func (s RemoteState) UpToDate() bool {
+	return s.Observed != nil && equality.Semantic.DeepEqual(s.Observed, s.Desired)
+}
+
+// A RemoteObserver observes the remote objects of Objects with the external
+// clients the controller connects, without persisting anything.
+type RemoteObserver struct {
+	conn *connector
+}
+
+// NewRemoteObserver returns a RemoteObserver that resolves references against
+// the supplied control plane client, which may be nil if Objects have none.
+// With ssa, desired states are computed with server-side apply, exactly as
+// with the server-side apply feature of the controller, using the supplied
+// caches and legacy client-side apply field managers.
+func NewRemoteObserver(local client.Client, ssa bool, parserCaches *extractor.GVKParserCacheManager, stateCaches state.CacheManager, legacyCSAFieldManagers sets.Set[string]) *RemoteObserver {
+	return &RemoteObserver{conn: &connector{
+		kube:                   local,
+		logger:                 logging.NewNopLogger(),
+		recorder:               event.NewNopRecorder(),
+		ssaEnabled:             ssa,
+		parserCacheManager:     parserCaches,
+		stateCacheManager:      stateCaches,
+		legacyCSAFieldManagers: legacyCSAFieldManagers,
+	}}
+}
+
+// ObserveRemoteState returns the state of the remote object of the supplied
+// Object in the cluster of the supplied client and REST config, computed
+// exactly as when the controller observes the Object. Nothing is persisted in
+// the target cluster. The manifest is subject to the policies of the supplied
+// provider config spec, exactly as when the controller applies it.
+func (o *RemoteObserver) ObserveRemoteState(ctx context.Context, obj *v1alpha1.Object, pc resource.ProviderConfig, spec *kconfig.ProviderConfigSpec, target client.Client, rc *rest.Config) (RemoteState, error) {
+	e, err := o.conn.newExternal(ctx, obj, pc, spec, target, rc)
+	if err != nil {
+		return RemoteState{}, err
+	}
+	return e.observeRemoteState(ctx, obj)
+}
+
+// observeRemoteState returns the state of the remote object of the supplied
+// Object. References are resolved against the local client, which may be nil
+// if the Object has none.
+func (e *external) observeRemoteState(ctx context.Context, obj *v1alpha1.Object) (RemoteState, error) {
+	if len(obj.Spec.References) > 0 {
+		if e.localClient == nil {
+			return RemoteState{}, errors.New(errReferencesNeedLocalClient)
+		}
+		if err := e.resolveReferencies(ctx, obj); err != nil {
+			return RemoteState{}, errors.Wrap(err, errResolveResourceReferences)
+		}
+	}
+
+	manifest, err := e.policyManifest(ctx, obj)
+	if err != nil {
+		return RemoteState{}, err
+	}
+
+	if manifest.GetName() == "" {
+		// The name of a manifest with only a generateName is generated when
+		// the remote object is created.
+		return RemoteState{Desired: manifest}, nil
+	}
+
+	s := RemoteState{}
+	current := manifest.DeepCopy()
+	err = e.client.Get(ctx, types.NamespacedName{
+		Namespace: current.GetNamespace(),
+		Name:      current.GetName(),
+	}, current)
+	switch {
+	case kerrors.IsNotFound(err):
+	case err != nil:
+		return RemoteState{}, errors.Wrap(err, errGetObject)
+	default:
+		s.Exists = true
+		// Late-initialized fields become part of the desired state, as
+		// when the controller observes the Object.
+		lateInitialized, err := lateInitialize(obj, current)
+		if err != nil {
+			return RemoteState{}, err
+		}
+		if lateInitialized {
+			if manifest, err = e.policyManifest(ctx, obj); err != nil {
+				return RemoteState{}, err
+			}
+		}
+		if s.Observed, err = e.syncer.GetObservedState(ctx, obj, current); err != nil {
+			return RemoteState{}, errors.Wrap(err, errGetObservedState)
+		}
+	}
+
+	if s.Desired, err = e.syncer.GetDesiredState(ctx, obj, manifest); err != nil {
+		return RemoteState{}, errors.Wrap(err, errGetDesiredState)
+	}
+	return s, nil
+}
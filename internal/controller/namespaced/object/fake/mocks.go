@@ -14,7 +14,8 @@ import (
 type ResourceSyncer struct {
 	GetObservedStateFn func(ctx context.Context, obj *v1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error)
 	GetDesiredStateFn  func(ctx context.Context, obj *v1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error)
-	SyncResourceFn     func(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	SyncResourceFn     func(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error)
+	DryRunResourceFn   func(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error)
 }
 
 // GetObservedState calls the GetObservedStateFn.
@@ -28,8 +29,13 @@ func (r *ResourceSyncer) GetDesiredState(ctx context.Context, obj *v1alpha1.Obje
 }
 
 // SyncResource calls the SyncResourceFn.
-func (r *ResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	return r.SyncResourceFn(ctx, obj, desired)
+func (r *ResourceSyncer) SyncResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+	return r.SyncResourceFn(ctx, obj, desired, create)
+}
+
+// DryRunResource calls the DryRunResourceFn.
+func (r *ResourceSyncer) DryRunResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+	return r.DryRunResourceFn(ctx, obj, desired, create)
 }
 
 type TrackerFn func(ctx context.Context, mg resource.ModernManaged) error
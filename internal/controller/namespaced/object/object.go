@@ -25,19 +25,22 @@ import (
 	"strings"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/google/cel-go/cel"
 	celtypes "github.com/google/cel-go/common/types"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -64,6 +67,7 @@ import (
 	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
 	"github.com/crossplane-contrib/provider-kubernetes/internal/features"
 	kubeclient "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client"
+	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa"
 	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/extractor"
 	"github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client/ssa/cache/state"
 	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
@@ -80,19 +84,28 @@ const (
 	errTrackPCUsage      = "cannot track ProviderConfig usage"
 	errGetObject         = "cannot get object"
 	errCreateObject      = "cannot create object"
+	errCreateIncomplete  = "cannot determine creation result of an object with a generated name - remove the " + meta.AnnotationKeyExternalCreatePending + " annotation if it is safe to proceed"
 	errApplyObject       = "cannot apply object"
+	errDryRunObject      = "cannot dry run object"
+	errDiffDryRun        = "cannot diff dry run result against the current object"
 	errDeleteObject      = "cannot delete object"
 
 	errCreateDiscoveryClient      = "cannot create discovery client"
 	errCreateSSAExtractor         = "cannot create new unstructured server side apply extractor"
+	errValidateManifest           = "cannot validate manifest against OpenAPI schema"
+	errInvalidManifest            = "manifest does not conform to OpenAPI schema"
 	errLoadSSAParserCacheTemplate = "cannot load parser cache for ProviderConfig %s"
 	errNotKubernetesObject        = "managed resource is not an Object custom resource"
 	errBuildKubeForProviderConfig = "cannot build kube client for provider config"
+	errDeletionBlocked            = "cannot delete remote %s %s: %s; remove Delete from the management policies of the Object to orphan it instead"
+
+	reasonDeletionBlocked event.Reason = "DeletionBlocked"
 
 	errGetObservedState        = "cannot get observed state"
 	errGetDesiredState         = "cannot get desired state"
 	errUnmarshalTemplate       = "cannot unmarshal template"
 	errFailedToMarshalExisting = "cannot marshal existing resource"
+	errLateInitialize          = "cannot late-initialize manifest"
 
 	errGetReferencedResource       = "cannot get referenced resource"
 	errPatchFromReferencedResource = "cannot patch from referenced resource"
@@ -148,8 +161,14 @@ type ResourceSyncer interface {
 	// https://github.com/kubernetes/kubernetes/issues/115563
 	// https://github.com/kubernetes/kubernetes/issues/124605
 	GetDesiredState(ctx context.Context, obj *v1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error)
-	// SyncResource syncs the desired state of the object manifest to the Kube API.
-	SyncResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	// SyncResource syncs the desired state of the object manifest to the Kube
+	// API. create reports whether the remote object does not exist yet.
+	SyncResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error)
+	// DryRunResource syncs the desired state of the object manifest to the
+	// Kube API with all changes dry-run, and returns the object the Kube API
+	// would have persisted. create reports whether the remote object does
+	// not exist yet.
+	DryRunResource(ctx context.Context, obj *v1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error)
 }
 
 // Setup adds a controller that reconciles Object managed resources.
@@ -157,6 +176,7 @@ func Setup(mgr ctrl.Manager, o controller.Options, sanitizeSecrets bool, removeM
 	name := managed.ControllerName(v1alpha1.ObjectGroupKind)
 	l := o.Logger.WithValues("controller", name)
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name)) //nolint:staticcheck // SA1019: keeping the legacy events API until crossplane-runtime's event package moves to GetEventRecorder
 	reconcilerOptions := []managed.ReconcilerOption{
 		managed.WithFinalizer(&objFinalizer{client: mgr.GetClient()}),
 		managed.WithPollInterval(o.PollInterval),
@@ -171,16 +191,18 @@ func Setup(mgr ctrl.Manager, o controller.Options, sanitizeSecrets bool, removeM
 			return pollInterval + time.Duration((rand.Float64()-0.5)*2*float64(pollJitter)) //nolint G404 // No need for secure randomness
 		}),
 		managed.WithLogger(l),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))), //nolint:staticcheck // SA1019: keeping the legacy events API until crossplane-runtime's event package moves to GetEventRecorder
+		managed.WithRecorder(recorder),
 		managed.WithMetricRecorder(o.MetricOptions.MRMetrics),
 		managed.WithDeterministicExternalName(true),
 	}
 
 	conn := &connector{
 		logger:              o.Logger,
+		recorder:            recorder,
 		sanitizeSecrets:     sanitizeSecrets,
 		removeManagedFields: removeManagedFields,
 		kube:                mgr.GetClient(),
+		namespaces:          mgr.GetAPIReader(),
 		usage:               resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
 		clientBuilder:       kubeclient.NewIdentityAwareBuilder(mgr.GetClient()),
 	}
@@ -194,6 +216,7 @@ func Setup(mgr ctrl.Manager, o controller.Options, sanitizeSecrets bool, removeM
 		csaFieldManagers := sets.New(defaultCSAFieldManager)
 		csaFieldManagers.Insert(legacyCSAFieldManagers...)
 		conn.legacyCSAFieldManagers = csaFieldManagers
+		conn.validateManifests = o.Features.Enabled(features.EnableAlphaManifestValidation)
 	}
 
 	cb := ctrl.NewControllerManagedBy(mgr).
@@ -270,6 +293,7 @@ type connector struct {
 	kube                client.Client
 	usage               modernTracker
 	logger              logging.Logger
+	recorder            event.Recorder
 	sanitizeSecrets     bool
 	removeManagedFields bool
 	kindObserver        KindObserver
@@ -277,10 +301,15 @@ type connector struct {
 
 	clientBuilder kubeclient.Builder
 
+	// namespaces reads the namespaces that ClusterProviderConfigs select
+	// by label. Namespaces are not cached, so it reads from the API server.
+	namespaces client.Reader
+
 	// server-side apply
 	stateCacheManager      state.CacheManager
 	parserCacheManager     *extractor.GVKParserCacheManager
 	legacyCSAFieldManagers sets.Set[string]
+	validateManifests      bool
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -289,22 +318,57 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotKubernetesObject)
 	}
 
-	if err := c.usage.Track(ctx, mg.(resource.ModernManaged)); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+	pc, pcSpec, err := ResolveProviderConfig(ctx, c.kube, c.namespaces, obj)
+	if v, ok := pcontroller.AsPolicyViolation(err); ok {
+		obj.SetConditions(v1alpha1.PolicyViolated(v.Reason, v.Message))
+		if meta.WasDeleted(obj) {
+			// The remote object of an Object that may not use its provider
+			// config can neither be deleted nor silently orphaned, so the
+			// Object keeps its finalizer until the provider config is allowed
+			// again, or until Delete is removed from its management policies,
+			// which orphans the remote object explicitly.
+			c.recordDeletionBlocked(obj, v)
+		}
+		return nil, err
 	}
-
-	pc, pcSpec, err := resolveProviderConfig(ctx, c.kube, obj)
 	if err != nil {
 		return nil, err
 	}
 
+	// Usage is only tracked once the Object is known to be allowed to use
+	// its provider config.
+	if err := c.usage.Track(ctx, mg.(resource.ModernManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
 	k, rc, err := c.clientBuilder.KubeForProviderConfig(ctx, *pcSpec)
 	if err != nil {
 		return nil, errors.Wrap(err, errBuildKubeForProviderConfig)
 	}
 
+	return c.newExternal(ctx, mg, pc, pcSpec, k, rc)
+}
+
+// newExternal returns an external client that syncs the supplied Object to
+// the cluster of the supplied client and REST config, subject to the policies
+// of the supplied provider config spec.
+// recordDeletionBlocked records a Warning event naming the remote object of
+// the supplied Object whose deletion is blocked by the supplied violation.
+func (c *connector) recordDeletionBlocked(obj *v1alpha1.Object, v *pcontroller.PolicyViolation) {
+	kind, name := "object", obj.GetName()
+	if manifest, err := parseManifest(obj); err == nil {
+		kind, name = manifest.GetKind(), manifest.GetName()
+		if ns := manifest.GetNamespace(); ns != "" {
+			name = ns + "/" + name
+		}
+	}
+	c.recorder.Event(obj, event.Warning(reasonDeletionBlocked, errors.Errorf(errDeletionBlocked, kind, name, v.Message)))
+}
+
+func (c *connector) newExternal(ctx context.Context, mg resource.Managed, pc resource.ProviderConfig, spec *kconfig.ProviderConfigSpec, k client.Client, rc *rest.Config) (*external, error) {
 	e := &external{
-		logger: c.logger,
+		logger:   c.logger,
+		recorder: c.recorder,
 		client: resource.ClientApplicator{
 			Client:     k,
 			Applicator: resource.NewAPIPatchingApplicator(k),
@@ -314,36 +378,31 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		sanitizeSecrets:     c.sanitizeSecrets,
 		removeManagedFields: c.removeManagedFields,
 
-		kindObserver: c.kindObserver,
-		syncer: &PatchingResourceSyncer{
-			client: resource.ClientApplicator{
-				Client:     k,
-				Applicator: resource.NewAPIPatchingApplicator(k),
-			},
-		},
+		kindObserver:    c.kindObserver,
+		syncer:          NewPatchingResourceSyncer(k),
+		namespacePolicy: spec.NamespacePolicy,
+		guardrails:      spec.Guardrails,
+		validationRules: spec.ValidationRules,
+	}
+	if r, ok := pc.(pcontroller.PolicyViolationRecorder); ok {
+		e.providerConfig = r
 	}
 
 	if c.ssaEnabled {
-		dc, err := discovery.NewDiscoveryClientForConfig(rc)
-		if err != nil {
-			return nil, errors.Wrap(err, errCreateDiscoveryClient)
-		}
 		parserCache, err := c.parserCacheManager.LoadOrNewCacheForProviderConfig(pc)
 		if err != nil {
 			return nil, errors.Wrapf(err, errLoadSSAParserCacheTemplate, pc.GetName())
 		}
-		applyExtractor, err := extractor.NewCachingUnstructuredExtractor(ctx, dc, parserCache)
+		s, err := NewSSAResourceSyncer(ctx, k, rc, parserCache, func() state.Cache {
+			return c.stateCacheManager.LoadOrNewForManaged(mg)
+		}, c.legacyCSAFieldManagers)
 		if err != nil {
-			return nil, errors.Wrap(err, errCreateSSAExtractor)
+			return nil, err
 		}
-		e.syncer = &SSAResourceSyncer{
-			client:    k,
-			extractor: applyExtractor,
-			desiredStateCacheFn: func() state.Cache {
-				return c.stateCacheManager.LoadOrNewForManaged(mg)
-			},
-			legacyCSAFieldManagers: c.legacyCSAFieldManagers,
+		if !c.validateManifests {
+			s.validator = nil
 		}
+		e.syncer = s
 		e.desiredStateCacheCleanupFn = func() {
 			c.stateCacheManager.Remove(mg)
 		}
@@ -353,9 +412,10 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 }
 
 type external struct {
-	logger logging.Logger
-	client resource.ClientApplicator
-	rest   *rest.Config
+	logger   logging.Logger
+	recorder event.Recorder
+	client   resource.ClientApplicator
+	rest     *rest.Config
 	// localClient is specifically used to connect to local cluster, a.k.a control plane.
 	localClient  client.Client
 	syncer       ResourceSyncer
@@ -364,6 +424,14 @@ type external struct {
 	sanitizeSecrets     bool
 	removeManagedFields bool
 
+	// providerConfig of the Object, which records its policy violations.
+	providerConfig pcontroller.PolicyViolationRecorder
+	// namespacePolicy, guardrails and validation rules of the provider
+	// config, if any.
+	namespacePolicy *kconfig.NamespacePolicy
+	guardrails      *kconfig.Guardrails
+	validationRules []kconfig.ValidationRule
+
 	// for cleaning-up the desired state cache of MR from
 	// state cache manager, when MR gets deleted
 	desiredStateCacheCleanupFn func()
@@ -384,7 +452,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
-	manifest, err := parseManifest(obj)
+	manifest, err := c.targetManifest(ctx, obj)
+	if isPolicyViolation(err) && meta.WasDeleted(obj) {
+		// The remote object of an Object that violates a policy is left
+		// alone, so that the Object can be deleted.
+		return managed.ExternalObservation{ResourceExists: false}, c.recordPolicyViolation(ctx, obj, nil)
+	}
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -393,6 +466,29 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		c.kindObserver.WatchResources(c.rest, providerConfigRefKey(obj), manifest.GroupVersionKind())
 	}
 
+	switch {
+	case obj.Spec.DryRun && (!meta.WasDeleted(obj) || obj.Status.AtProvider.UID == ""):
+		return c.observeDryRun(ctx, obj, manifest)
+	case obj.Spec.DryRun:
+		// An Object that managed its remote object before dry run was
+		// enabled is deleted according to its deletion and management
+		// policies, rather than orphaning the remote object.
+	case obj.Status.AtProvider.DryRun != nil:
+		obj.Status.AtProvider.DryRun = nil
+		obj.SetConditions(v1alpha1.DryRunDisabled())
+	}
+
+	if manifest.GetName() == "" {
+		// The remote object of a manifest with only a generateName does not
+		// exist until Create records its name. If a previous Create did not
+		// complete, the object may exist under a name that is lost, so it is
+		// not created again.
+		if meta.ExternalCreateIncomplete(obj) {
+			return managed.ExternalObservation{}, errors.New(errCreateIncomplete)
+		}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	current := manifest.DeepCopy()
 	err = c.client.Get(ctx, types.NamespacedName{
 		Namespace: current.GetNamespace(),
@@ -400,6 +496,17 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, current)
 
 	if kerrors.IsNotFound(err) {
+		// A remote object that was observed before, but is missing although
+		// the Object is not being deleted, was deleted externally. If the
+		// manifest was renamed since, the remote object under the new name
+		// is yet to be created.
+		if obj.Status.AtProvider.UID != "" && !meta.WasDeleted(obj) {
+			if !remoteIdentityMatches(obj, manifest) {
+				clearRemoteIdentity(obj)
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}
+			return c.observeExternalDeletion(ctx, obj, manifest)
+		}
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
@@ -407,10 +514,32 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
 	}
 
+	observeExternalRecreation(obj)
+
+	gone, err := c.observeReplacement(obj, current)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if gone {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
 	if err = c.setAtProvider(obj, current); err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
+	// Late-initialized fields become part of the desired state right away,
+	// so that they are applied, and thereby owned, with the next update.
+	lateInitialized, err := lateInitialize(obj, current)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if lateInitialized {
+		if manifest, err = c.targetManifest(ctx, obj); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	// observedState contains the extracted state of the current object that
 	// should be compared with the desired state of the object to decide whether
 	// the object is up-to-date or not.
@@ -427,7 +556,9 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetDesiredState)
 	}
 
-	return c.handleObservation(ctx, obj, observedState, desiredState)
+	o, err := c.handleObservation(ctx, obj, observedState, desiredState)
+	o.ResourceLateInitialized = lateInitialized
+	return o, err
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -438,15 +569,32 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	c.logger.Debug("Creating", "resource", obj)
 
-	res, err := parseManifest(obj)
+	res, err := c.targetManifest(ctx, obj)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
-	current, err := c.syncer.SyncResource(ctx, obj, res)
+	create := true
+	if res.GetName() == "" {
+		// Server-side apply cannot generate names, so the remote object is
+		// created first. Its name is recorded as the external name, which
+		// the managed reconciler persists right after Create.
+		create = false
+		created := res.DeepCopy()
+		if err := c.client.Create(ctx, created); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(CleanErr(err), errCreateObject)
+		}
+		meta.SetExternalName(obj, created.GetName())
+		res.SetName(created.GetName())
+	}
+
+	current, err := c.syncer.SyncResource(ctx, obj, res, create)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(CleanErr(err), errCreateObject)
 	}
+	if err := c.recordCreatedUID(ctx, obj, current); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	return managed.ExternalCreation{}, c.setAtProvider(obj, current)
 }
 
@@ -458,12 +606,18 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	c.logger.Debug("Updating", "resource", obj)
 
-	res, err := parseManifest(obj)
+	res, err := c.targetManifest(ctx, obj)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
+	if obj.Spec.Replacement.UpdatePrecondition {
+		// The API server rejects changes to the UID of an object, so the
+		// update fails if the remote object was replaced since it was
+		// observed.
+		res.SetUID(obj.Status.AtProvider.UID)
+	}
 
-	current, err := c.syncer.SyncResource(ctx, obj, res)
+	current, err := c.syncer.SyncResource(ctx, obj, res, false)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(CleanErr(err), errApplyObject)
 	}
@@ -481,10 +635,14 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	deleteOptions := &client.DeleteOptions{
 		PropagationPolicy: &propagationPolicy,
 	}
+	if uid := obj.Status.AtProvider.UID; uid != "" {
+		// Never delete a remote object that replaced the observed one.
+		deleteOptions.Preconditions = &metav1.Preconditions{UID: &uid}
+	}
 
 	c.logger.Debug("Deleting", "resource", obj, "propagationPolicy", obj.Spec.ForProvider.DeletionPropagationPolicy)
 
-	res, err := parseManifest(obj)
+	res, err := c.targetManifest(ctx, obj)
 	if err != nil {
 		return managed.ExternalDelete{}, err
 	}
@@ -502,7 +660,7 @@ func (c *external) Disconnect(ctx context.Context) error {
 }
 
 func ssaFieldOwner(name string) string {
-	return fmt.Sprintf("provider-kubernetes/%s", name)
+	return ssa.FieldOwner(name)
 }
 
 func parseManifest(obj *v1alpha1.Object) (*unstructured.Unstructured, error) {
@@ -511,17 +669,131 @@ func parseManifest(obj *v1alpha1.Object) (*unstructured.Unstructured, error) {
 		return nil, errors.Wrap(err, errUnmarshalTemplate)
 	}
 
-	if r.GetName() == "" {
-		r.SetName(obj.Name)
-	}
+	setRemoteName(obj, r)
 
 	return r, nil
 }
 
-func (c *external) setAtProvider(obj *v1alpha1.Object, observed *unstructured.Unstructured) error {
-	var err error
+// setRemoteName sets the name of the remote object of the supplied Object on
+// the supplied manifest. An external name takes precedence over the name in
+// the manifest, e.g. to adopt an existing remote object, unless it is the name
+// of the Object itself, which the managed reconciler defaults it to. A
+// manifest with neither a name nor a generateName is named after the Object,
+// while one with only a generateName stays unnamed until Create records the
+// name generated by the API server as the external name.
+func setRemoteName(obj *v1alpha1.Object, r *unstructured.Unstructured) {
+	if en := meta.GetExternalName(obj); en != "" && en != obj.GetName() {
+		r.SetName(en)
+		return
+	}
+	if r.GetName() == "" && r.GetGenerateName() == "" {
+		r.SetName(obj.GetName())
+	}
+}
 
-	// sanitize/mutate only the copied object
+// observeDryRun syncs the manifest to the target API server with all changes
+// dry-run and records the outcome in the status of the Object. The remote
+// object is reported as existing and up-to-date, so that the managed
+// reconciler never calls Create, Update or Delete, which would persist
+// changes.
+func (c *external) observeDryRun(ctx context.Context, obj *v1alpha1.Object, manifest *unstructured.Unstructured) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(obj) {
+		// Nothing was ever persisted on behalf of an Object that has only
+		// run in dry run, so there is nothing to delete either.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if manifest.GetName() == "" {
+		// Server-side apply requires a name, so a random one stands in for
+		// the name the API server would generate.
+		manifest.SetName(manifest.GetGenerateName() + utilrand.String(5))
+	}
+
+	current := manifest.DeepCopy()
+	err := c.client.Get(ctx, types.NamespacedName{
+		Namespace: current.GetNamespace(),
+		Name:      current.GetName(),
+	}, current)
+	if resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObject)
+	}
+	// A remote object observed in dry run is not recorded as the one of the
+	// Object, so that deleting the Object in dry run never deletes it.
+	if kerrors.IsNotFound(err) {
+		current = nil
+	} else if err := c.setObserved(obj, current); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	result, err := c.syncer.DryRunResource(ctx, obj, manifest.DeepCopy(), current == nil)
+	if err != nil {
+		obj.SetConditions(v1alpha1.DryRunFailed(err.Error()))
+		return managed.ExternalObservation{}, err
+	}
+
+	sResult, err := c.sanitize(result)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	var sCurrent *unstructured.Unstructured
+	if current != nil {
+		if sCurrent, err = c.sanitize(current); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	diff, err := dryRunDiff(sCurrent, sResult)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	raw, err := sResult.MarshalJSON()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFailedToMarshalExisting)
+	}
+	obj.Status.AtProvider.DryRun = &v1alpha1.DryRunResult{
+		Manifest: runtime.RawExtension{Raw: raw},
+		Diff:     diff,
+	}
+
+	switch {
+	case current == nil:
+		obj.SetConditions(v1alpha1.DryRunSucceeded(v1alpha1.ReasonDryRunCreate))
+	case diff == "{}":
+		obj.SetConditions(v1alpha1.DryRunSucceeded(v1alpha1.ReasonDryRunNoChanges))
+	default:
+		obj.SetConditions(v1alpha1.DryRunSucceeded(v1alpha1.ReasonDryRunUpdate))
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// dryRunDiff returns a JSON merge patch from current, or from an empty object
+// if it is nil, to the result of a dry run. Fields that change on every write
+// are ignored.
+func dryRunDiff(current, result *unstructured.Unstructured) (string, error) {
+	from := []byte("{}")
+	if current != nil {
+		var err error
+		if from, err = json.Marshal(withoutVolatileFields(current).Object); err != nil {
+			return "", errors.Wrap(err, errDiffDryRun)
+		}
+	}
+	to, err := json.Marshal(withoutVolatileFields(result).Object)
+	if err != nil {
+		return "", errors.Wrap(err, errDiffDryRun)
+	}
+	patch, err := jsonpatch.CreateMergePatch(from, to)
+	return string(patch), errors.Wrap(err, errDiffDryRun)
+}
+
+func withoutVolatileFields(u *unstructured.Unstructured) *unstructured.Unstructured {
+	c := u.DeepCopy()
+	c.SetManagedFields(nil)
+	c.SetResourceVersion("")
+	return c
+}
+
+// sanitize returns a copy of the supplied remote object that is safe to be
+// stored in the status of the Object.
+func (c *external) sanitize(observed *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	sObserved := observed.DeepCopy()
 	if c.removeManagedFields {
 		sObserved.SetManagedFields(nil)
@@ -529,20 +801,37 @@ func (c *external) setAtProvider(obj *v1alpha1.Object, observed *unstructured.Un
 	if c.sanitizeSecrets {
 		if observed.GetKind() == "Secret" && observed.GetAPIVersion() == "v1" {
 			data := map[string][]byte{"redacted": []byte(nil)}
-			if err = fieldpath.Pave(sObserved.Object).SetValue("data", data); err != nil {
-				return errors.Wrap(err, errSanitizeSecretData)
+			if err := fieldpath.Pave(sObserved.Object).SetValue("data", data); err != nil {
+				return nil, errors.Wrap(err, errSanitizeSecretData)
 			}
 		}
 	}
+	return sObserved, nil
+}
 
-	if obj.Status.AtProvider.Manifest.Raw, err = sObserved.MarshalJSON(); err != nil {
-		return errors.Wrap(err, errFailedToMarshalExisting)
+func (c *external) setAtProvider(obj *v1alpha1.Object, observed *unstructured.Unstructured) error {
+	if err := c.setObserved(obj, observed); err != nil {
+		return err
 	}
+	setRemoteIdentity(obj, observed)
+	return nil
+}
 
-	if err := c.updateConditionFromObserved(obj, observed); err != nil {
+// setObserved records the supplied remote object and the conditions derived
+// from it in the status of the supplied Object, without recording its
+// identity, i.e. without the Object taking over the remote object.
+func (c *external) setObserved(obj *v1alpha1.Object, observed *unstructured.Unstructured) error {
+	// sanitize/mutate only the copied object
+	sObserved, err := c.sanitize(observed)
+	if err != nil {
 		return err
 	}
-	return nil
+
+	if obj.Status.AtProvider.Manifest.Raw, err = sObserved.MarshalJSON(); err != nil {
+		return errors.Wrap(err, errFailedToMarshalExisting)
+	}
+
+	return c.updateConditionFromObserved(obj, observed)
 }
 
 func (c *external) updateConditionFromObserved(obj *v1alpha1.Object, observed *unstructured.Unstructured) error {
@@ -940,7 +1229,12 @@ func unstructuredFromObjectRef(r v1.ObjectReference) unstructured.Unstructured {
 	return u
 }
 
-func resolveProviderConfig(ctx context.Context, kube client.Client, obj *v1alpha1.Object) (resource.ProviderConfig, *kconfig.ProviderConfigSpec, error) {
+// ResolveProviderConfig returns the ProviderConfig or ClusterProviderConfig
+// the supplied Object refers to, along with its spec. A ClusterProviderConfig
+// that may not be used in the namespace of the Object is rejected with a
+// policy violation. The namespace is read with the supplied namespaces reader
+// if the ClusterProviderConfig selects its allowed namespaces by label.
+func ResolveProviderConfig(ctx context.Context, kube client.Client, namespaces client.Reader, obj *v1alpha1.Object) (resource.ProviderConfig, *kconfig.ProviderConfigSpec, error) {
 	var pc resource.ProviderConfig
 	var pcSpec *kconfig.ProviderConfigSpec
 	switch obj.Spec.ProviderConfigReference.Kind {
@@ -956,7 +1250,10 @@ func resolveProviderConfig(ctx context.Context, kube client.Client, obj *v1alpha
 		if err := kube.Get(ctx, client.ObjectKey{Name: obj.Spec.ProviderConfigReference.Name}, cpc); err != nil {
 			return nil, nil, errors.Wrap(err, errGetProviderConfig)
 		}
-		pcSpec = &cpc.Spec
+		if err := pcontroller.CheckClusterProviderConfigAllowed(ctx, namespaces, cpc, obj.GetNamespace()); err != nil {
+			return nil, nil, err
+		}
+		pcSpec = &cpc.Spec.ProviderConfigSpec
 		pc = cpc
 	default:
 		return nil, nil, errors.Errorf("unknown provider config kind: %q", obj.Spec.ProviderConfigReference.Kind)
@@ -37,7 +37,9 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -45,6 +47,7 @@ import (
 
 	objv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
 	kubernetesv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
 	"github.com/crossplane-contrib/provider-kubernetes/internal/controller/namespaced/object/fake"
 	kubeclient "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client"
 	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
@@ -230,6 +233,14 @@ func TestConnect(t *testing.T) {
 	providerConfigUnknownIdentitySource := *providerConfigAzure.DeepCopy()
 	providerConfigUnknownIdentitySource.Spec.Identity.Type = "foo"
 
+	clusterProviderConfig := kubernetesv1alpha1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: kubernetesv1alpha1.ClusterProviderConfigSpec{
+			ProviderConfigSpec: providerConfig.Spec,
+			AllowedNamespaces:  &kubernetesv1alpha1.AllowedNamespaces{Names: []string{"other"}},
+		},
+	}
+
 	type args struct {
 		client            client.Client
 		clientForProvider client.Client
@@ -253,6 +264,12 @@ func TestConnect(t *testing.T) {
 		},
 		"FailedToTrackUsage": {
 			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*kubernetesv1alpha1.ProviderConfig) = providerConfig
+						return nil
+					}),
+				},
 				usage: modernTrackerFn(func(ctx context.Context, mg resource.ModernManaged) error { return errBoom }),
 				mg:    kubernetesObject(),
 			},
@@ -260,6 +277,43 @@ func TestConnect(t *testing.T) {
 				err: errors.Wrap(errBoom, errTrackPCUsage),
 			},
 		},
+		"ClusterProviderConfigNotAllowed": {
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*kubernetesv1alpha1.ClusterProviderConfig) = clusterProviderConfig
+						return nil
+					}),
+				},
+				// Usage must not be tracked for a disallowed use.
+				usage: modernTrackerFn(func(ctx context.Context, mg resource.ModernManaged) error { return errBoom }),
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ProviderConfigReference.Kind = kubernetesv1alpha1.ClusterProviderConfigKind
+				}),
+			},
+			want: want{
+				err: pcontroller.NewPolicyViolation(pcontroller.ReasonProviderConfigNotAllowed, "ClusterProviderConfig %s may not be used by resources in namespace %s", providerName, testNamespace),
+			},
+		},
+		"ClusterProviderConfigNotAllowedWhileDeleting": {
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*kubernetesv1alpha1.ClusterProviderConfig) = clusterProviderConfig
+						return nil
+					}),
+				},
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ProviderConfigReference.Kind = kubernetesv1alpha1.ClusterProviderConfigKind
+					obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				}),
+			},
+			want: want{
+				// The Object keeps its finalizer rather than orphaning its
+				// remote object.
+				err: pcontroller.NewPolicyViolation(pcontroller.ReasonProviderConfigNotAllowed, "ClusterProviderConfig %s may not be used by resources in namespace %s", providerName, testNamespace),
+			},
+		},
 		"Success": {
 			args: args{
 				client: &test.MockClient{
@@ -284,7 +338,8 @@ func TestConnect(t *testing.T) {
 				clientBuilder: kubeclient.BuilderFn(func(ctx context.Context, pc kconfig.ProviderConfigSpec) (client.Client, *rest.Config, error) {
 					return tc.args.clientForProvider, nil, nil
 				}),
-				usage: tc.usage,
+				usage:    tc.usage,
+				recorder: event.NewNopRecorder(),
 			}
 			_, gotErr := c.Connect(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -298,6 +353,7 @@ func TestObserve(t *testing.T) {
 	type args struct {
 		client resource.ClientApplicator
 		syncer ResourceSyncer
+		policy *kconfig.NamespacePolicy
 		mg     resource.Managed
 	}
 	type want struct {
@@ -409,6 +465,345 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"GeneratedNameNotCreated": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GeneratedNameCreateIncomplete": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+					meta.SetExternalCreatePending(obj, time.Now())
+				}),
+			},
+			want: want{
+				err: errors.New(errCreateIncomplete),
+			},
+		},
+		"ExternalNameOverridesManifest": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					meta.SetExternalName(obj, "adopted")
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+							if key.Name != "adopted" {
+								return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+							}
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetName("adopted")
+							})
+							return nil
+						},
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *objv1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *objv1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"ReplacementAdopted": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *objv1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *objv1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RenamedNotReplaced": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.Replacement.Policy = objv1alpha1.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *objv1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *objv1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ReplacementRefused": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.Replacement.Policy = objv1alpha1.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errRemoteObjectReplaced, someUID, "new-uid"),
+			},
+		},
+		"ReplacementRefusedWhileDeleting": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.Replacement.Policy = objv1alpha1.ReplacementPolicyRefuse
+					obj.Status.AtProvider.UID = someUID
+					obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetUID("new-uid")
+							})
+							return nil
+						}),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedRecreate": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyRecreate
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedReport": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyReport
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RenamedDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = "renamed-from"
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExternallyDeletedRecreate": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ExternallyDeletedReport": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyReport
+					obj.Status.AtProvider.UID = someUID
+					obj.Status.AtProvider.Name = externalResourceName
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExternallyDeletedDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(nil),
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExternallyDeletedFailedToDeleteObject": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ExternalDeletionPolicy = objv1alpha1.ExternalDeletionPolicyDeleteObject
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+						MockDelete: test.NewMockDeleteFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteExternallyDeleted),
+			},
+		},
+		"NamespaceNotAllowed": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"elsewhere"}}`)
+				}),
+				policy: &kconfig.NamespacePolicy{AllowedNamespaces: []string{testNamespace}},
+			},
+			want: want{
+				err: pcontroller.NewPolicyViolation(pcontroller.ReasonNamespaceNotAllowed, errNamespaceNotAllowed, "elsewhere"),
+			},
+		},
+		"NamespaceNotAllowedWhileDeleting": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"elsewhere"}}`)
+					obj.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				}),
+				policy: &kconfig.NamespacePolicy{AllowedNamespaces: []string{testNamespace}},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.LateInitializePaths = []string{"metadata.labels"}
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+								res.SetLabels(map[string]string{"kubernetes.io/metadata.name": externalResourceName})
+							})
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(ctx context.Context, obj *objv1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(ctx context.Context, obj *objv1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
 		"FailedToPatchFieldFromReferenceObject": {
 			args: args{
 				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
@@ -697,9 +1092,12 @@ func TestObserve(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
 				logger:      logging.NewNopLogger(),
+				recorder:    event.NewNopRecorder(),
 				client:      tc.args.client,
 				localClient: tc.args.client.Client,
 				syncer:      tc.args.syncer,
+
+				namespacePolicy: tc.args.policy,
 			}
 			got, gotErr := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -715,6 +1113,7 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
+		client resource.ClientApplicator
 		mg     resource.Managed
 		syncer ResourceSyncer
 	}
@@ -748,7 +1147,7 @@ func TestCreate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return nil, errBoom
 					},
 				},
@@ -765,7 +1164,7 @@ func TestCreate(t *testing.T) {
 				    "kind": "Namespace" }`)
 				}),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						if desired.GetName() != testObjectName {
 							t.Errorf("Name should default to object name when not provider in manifest")
 						}
@@ -777,11 +1176,81 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"FailedToCreateWithGeneratedName": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockCreate: test.NewMockCreateFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateObject),
+			},
+		},
+		"SuccessRecordsRecreatedUID": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+							if uid := obj.(*objv1alpha1.Object).Status.AtProvider.UID; uid != "new-uid" {
+								t.Errorf("The UID of the recreated object should be persisted, got %q", uid)
+							}
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						desired.SetUID("new-uid")
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessRecordsGeneratedName": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.ForProvider.Manifest.Raw = []byte(`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"generateName": "foo-"}}`)
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+							obj.SetName(obj.GetGenerateName() + "abcde")
+							return nil
+						}),
+					},
+				},
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						if desired.GetName() != "foo-abcde" {
+							t.Errorf("Name should be the one generated by the API server, got %q", desired.GetName())
+						}
+						if meta.GetExternalName(obj) != "foo-abcde" {
+							t.Errorf("Generated name should be recorded as the external name, got %q", meta.GetExternalName(obj))
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"Success": {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return desired, nil
 					},
 				},
@@ -794,8 +1263,10 @@ func TestCreate(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
-				logger: logging.NewNopLogger(),
-				syncer: tc.args.syncer,
+				logger:      logging.NewNopLogger(),
+				client:      tc.args.client,
+				localClient: tc.args.client.Client,
+				syncer:      tc.args.syncer,
 			}
 			got, gotErr := e.Create(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -844,7 +1315,7 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return nil, errBoom
 					},
 				},
@@ -853,6 +1324,25 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errApplyObject),
 			},
 		},
+		"SuccessWithUIDPrecondition": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Spec.Replacement.UpdatePrecondition = true
+					obj.Status.AtProvider.UID = someUID
+				}),
+				syncer: &fake.ResourceSyncer{
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						if desired.GetUID() != someUID {
+							t.Errorf("Update should be preconditioned on the observed UID, got %q", desired.GetUID())
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"SuccessDefaultsToObjectName": {
 			args: args{
 				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
@@ -861,7 +1351,7 @@ func TestUpdate(t *testing.T) {
 				    "kind": "Namespace" }`)
 				}),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						if desired.GetName() != testObjectName {
 							t.Errorf("Name should default to object name when not provider in manifest")
 						}
@@ -877,7 +1367,7 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				mg: kubernetesObject(),
 				syncer: &fake.ResourceSyncer{
-					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					SyncResourceFn: func(ctx context.Context, obj *objv1alpha1.Object, desired *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
 						return desired, nil
 					},
 				},
@@ -983,6 +1473,30 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessWithUIDPrecondition": {
+			args: args{
+				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{
+						MockDelete: func(_ context.Context, _ client.Object, opts ...client.DeleteOption) error {
+							do := &client.DeleteOptions{}
+							for _, o := range opts {
+								o.ApplyToDelete(do)
+							}
+							if do.Preconditions == nil || do.Preconditions.UID == nil || *do.Preconditions.UID != someUID {
+								t.Errorf("expected a precondition on UID %q, got %v", someUID, do.Preconditions)
+							}
+							return nil
+						},
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"SuccessWithForegroundPropagation": {
 			args: args{
 				mg: kubernetesObject(func(obj *objv1alpha1.Object) {
@@ -2032,3 +2546,219 @@ func TestSetAtProviderRemovesManagedFields(t *testing.T) {
 		})
 	}
 }
+
+func TestObserveDryRun(t *testing.T) {
+	dryRun := func(obj *objv1alpha1.Object) {
+		obj.Spec.DryRun = true
+	}
+	labelled := func(res *unstructured.Unstructured) {
+		res.SetLabels(map[string]string{"foo": "bar"})
+	}
+	type args struct {
+		client client.Client
+		syncer ResourceSyncer
+		mg     *objv1alpha1.Object
+	}
+	type want struct {
+		out    managed.ExternalObservation
+		err    error
+		reason xpv2.ConditionReason
+		diff   string
+		uid    string
+	}
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"WouldCreate": {
+			reason: "A dry run against a missing remote object should report the whole object as the diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *objv1alpha1.Object, desired *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+						if !create {
+							return nil, errBoom
+						}
+						return desired, nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: objv1alpha1.ReasonDryRunCreate,
+				diff:   `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"crossplane-system"}}`,
+			},
+		},
+		"WouldUpdate": {
+			reason: "A dry run that changes the remote object should report the changes as the diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetResourceVersion("1")
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *objv1alpha1.Object, _ *unstructured.Unstructured, create bool) (*unstructured.Unstructured, error) {
+						if create {
+							return nil, errBoom
+						}
+						return externalResource(labelled, func(res *unstructured.Unstructured) {
+							res.SetResourceVersion("2")
+						}), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: objv1alpha1.ReasonDryRunUpdate,
+				diff:   `{"metadata":{"labels":{"foo":"bar"}}}`,
+			},
+		},
+		"NotTakenOver": {
+			reason: "A dry run against an existing remote object should not record it as the remote object of the Object.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *objv1alpha1.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						}), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: objv1alpha1.ReasonDryRunNoChanges,
+				diff:   `{}`,
+			},
+		},
+		"NoChanges": {
+			reason: "A dry run that does not change the remote object should report an empty diff.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource()
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *objv1alpha1.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return externalResource(), nil
+					},
+				},
+			},
+			want: want{
+				out:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: objv1alpha1.ReasonDryRunNoChanges,
+				diff:   `{}`,
+			},
+		},
+		"DryRunFailed": {
+			reason: "A dry run rejected by the API server should be returned and reflected in the condition.",
+			args: args{
+				mg: kubernetesObject(dryRun),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+				syncer: &fake.ResourceSyncer{
+					DryRunResourceFn: func(_ context.Context, _ *objv1alpha1.Object, _ *unstructured.Unstructured, _ bool) (*unstructured.Unstructured, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err:    errBoom,
+				reason: objv1alpha1.ReasonDryRunFailed,
+			},
+		},
+		"Deleting": {
+			reason: "An Object in dry run should be released on deletion without touching the remote object.",
+			args: args{
+				mg: kubernetesObject(dryRun, func(obj *objv1alpha1.Object) {
+					now := metav1.Now()
+					obj.SetDeletionTimestamp(&now)
+				}),
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DeletingManaged": {
+			reason: "An Object that managed its remote object before dry run was enabled should observe it on deletion, so that it is deleted rather than orphaned.",
+			args: args{
+				mg: kubernetesObject(dryRun, func(obj *objv1alpha1.Object) {
+					now := metav1.Now()
+					obj.SetDeletionTimestamp(&now)
+					obj.Status.AtProvider.UID = someUID
+				}),
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						*obj.(*unstructured.Unstructured) = *externalResource(func(res *unstructured.Unstructured) {
+							res.SetUID(someUID)
+						})
+						return nil
+					}),
+				},
+				syncer: &fake.ResourceSyncer{
+					GetObservedStateFn: func(_ context.Context, _ *objv1alpha1.Object, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return current, nil
+					},
+					GetDesiredStateFn: func(_ context.Context, _ *objv1alpha1.Object, manifest *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+						return manifest, nil
+					},
+				},
+			},
+			want: want{
+				out: managed.ExternalObservation{ResourceExists: true},
+				uid: someUID,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				logger:      logging.NewNopLogger(),
+				client:      resource.ClientApplicator{Client: tc.args.client},
+				localClient: tc.args.client,
+				syncer:      tc.args.syncer,
+			}
+			got, gotErr := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.Observe(...): -want error, +got error: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want out, +got out: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, tc.args.mg.GetCondition(objv1alpha1.TypeDryRun).Reason); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want reason, +got reason: %s", tc.reason, diff)
+			}
+			gotDiff := ""
+			if r := tc.args.mg.Status.AtProvider.DryRun; r != nil {
+				gotDiff = r.Diff
+			}
+			if diff := cmp.Diff(tc.want.diff, gotDiff); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want diff, +got diff: %s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.uid, string(tc.args.mg.Status.AtProvider.UID)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want uid, +got uid: %s", tc.reason, diff)
+			}
+		})
+	}
+}
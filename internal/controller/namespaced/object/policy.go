@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	errNamespaceNotAllowed = "namespace %q of the target cluster is not allowed by the namespace policy of the provider config"
+	errGetManifestScope    = "cannot determine whether the manifest is namespace-scoped"
+	errListPCUsages        = "cannot list provider config usages"
+)
+
+// isPolicyViolation returns true if the supplied error is a policy violation.
+func isPolicyViolation(err error) bool {
+	_, ok := pcontroller.AsPolicyViolation(err)
+	return ok
+}
+
+// targetManifest parses the manifest of the supplied Object and applies the
+// policies of its provider config to it. A violation is reported in the
+// PolicyCompliant condition of the Object and in the status of the provider
+// config.
+func (c *external) targetManifest(ctx context.Context, obj *v1alpha1.Object) (*unstructured.Unstructured, error) {
+	manifest, err := c.policyManifest(ctx, obj)
+	if v, ok := pcontroller.AsPolicyViolation(err); ok {
+		obj.SetConditions(v1alpha1.PolicyViolated(v.Reason, v.Message))
+		if err := c.recordPolicyViolation(ctx, obj, v); err != nil {
+			return nil, err
+		}
+		return nil, v
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.GetCondition(v1alpha1.TypePolicyCompliant).Status == corev1.ConditionFalse {
+		obj.SetConditions(v1alpha1.PolicyCompliant())
+	}
+	if err := c.recordPolicyViolation(ctx, obj, nil); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// policyManifest parses the manifest of the supplied Object and applies the
+// policies of its provider config to it, without recording the outcome.
+func (c *external) policyManifest(ctx context.Context, obj *v1alpha1.Object) (*unstructured.Unstructured, error) {
+	manifest, err := parseManifest(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPolicies(ctx, obj, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// checkPolicies applies the namespace policy of the provider config to the
+// supplied manifest, and checks it against the guardrails and validation
+// rules of the provider config.
+func (c *external) checkPolicies(ctx context.Context, obj *v1alpha1.Object, manifest *unstructured.Unstructured) error {
+	if err := c.applyNamespacePolicy(obj, manifest); err != nil {
+		return err
+	}
+	if err := pcontroller.CheckGuardrails(c.guardrails, manifest, c.isNamespaced); err != nil {
+		return err
+	}
+	if err := pcontroller.CheckValidationRules(c.validationRules, manifest); err != nil {
+		return err
+	}
+	if c.guardrails == nil || c.guardrails.MaxObjects == nil || obj.Status.AtProvider.UID != "" || c.localClient == nil {
+		// Only Objects that are yet to create or adopt a remote object are
+		// subject to the maximum number of Objects, which can only be
+		// counted with access to the control plane.
+		return nil
+	}
+	usages, err := c.providerConfigUsages(ctx, obj)
+	if err != nil {
+		return err
+	}
+	return pcontroller.CheckMaxObjects(c.guardrails, obj.GetUID(), usages)
+}
+
+// applyNamespacePolicy sets the namespace of the supplied manifest according
+// to the namespace policy of the provider config, and rejects it if that
+// namespace is not allowed.
+func (c *external) applyNamespacePolicy(obj *v1alpha1.Object, manifest *unstructured.Unstructured) error {
+	return ApplyNamespacePolicy(c.namespacePolicy, obj.GetNamespace(), manifest, c.isNamespaced)
+}
+
+// ApplyNamespacePolicy sets the namespace of the supplied manifest of an
+// Object in the supplied namespace according to the supplied namespace
+// policy, and rejects it if that namespace is not allowed. isNamespaced
+// reports whether the manifest is namespace-scoped in the target cluster.
+func ApplyNamespacePolicy(p *kconfig.NamespacePolicy, objectNamespace string, manifest *unstructured.Unstructured, isNamespaced func(runtime.Object) (bool, error)) error {
+	if p == nil {
+		return nil
+	}
+
+	namespaced := false
+	if manifest.GetNamespace() == "" && (p.InheritObjectNamespace || len(p.Mappings) > 0) {
+		var err error
+		if namespaced, err = isNamespaced(manifest); err != nil {
+			return errors.Wrap(err, errGetManifestScope)
+		}
+	}
+	manifest.SetNamespace(p.TargetNamespace(objectNamespace, manifest.GetNamespace(), namespaced))
+
+	if !p.Allows(manifest.GetNamespace()) {
+		return pcontroller.NewPolicyViolation(pcontroller.ReasonNamespaceNotAllowed, errNamespaceNotAllowed, manifest.GetNamespace())
+	}
+	return nil
+}
+
+// isNamespaced returns true if the supplied object is namespace-scoped in the
+// target cluster.
+func (c *external) isNamespaced(o runtime.Object) (bool, error) {
+	return c.client.IsObjectNamespaced(o)
+}
+
+// providerConfigUsages returns the usages of the provider config of the
+// supplied Object. The usages of a ClusterProviderConfig span all namespaces.
+func (c *external) providerConfigUsages(ctx context.Context, obj *v1alpha1.Object) ([]resource.ProviderConfigUsage, error) {
+	ref := obj.GetProviderConfigReference()
+	opts := []client.ListOption{client.MatchingLabels{
+		xpv2.LabelKeyProviderName: ref.Name,
+		xpv2.LabelKeyProviderKind: ref.Kind,
+	}}
+	if ref.Kind == apisv1alpha1.ProviderConfigKind {
+		opts = append(opts, client.InNamespace(obj.GetNamespace()))
+	}
+
+	l := &apisv1alpha1.ProviderConfigUsageList{}
+	if err := c.localClient.List(ctx, l, opts...); err != nil {
+		return nil, errors.Wrap(err, errListPCUsages)
+	}
+	usages := make([]resource.ProviderConfigUsage, len(l.Items))
+	for i := range l.Items {
+		usages[i] = &l.Items[i]
+	}
+	return usages, nil
+}
+
+// recordPolicyViolation records the supplied policy violation of the supplied
+// Object in the status of its provider config. A nil violation removes any
+// recorded violation of the Object.
+func (c *external) recordPolicyViolation(ctx context.Context, obj *v1alpha1.Object, v *pcontroller.PolicyViolation) error {
+	if c.providerConfig == nil {
+		return nil
+	}
+	return pcontroller.RecordPolicyViolation(ctx, c.localClient, c.providerConfig, kconfig.PolicyViolation{
+		Kind:      v1alpha1.ObjectKind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}, v)
+}
@@ -0,0 +1,160 @@
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	objv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+func TestTargetManifest(t *testing.T) {
+	configMap := func(namespace string) []byte {
+		if namespace == "" {
+			return []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo"}}`)
+		}
+		return []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"foo","namespace":"` + namespace + `"}}`)
+	}
+	namespaced := func(namespaced bool, err error) test.MockIsObjectNamespacedFn {
+		return func(runtime.Object) (bool, error) { return namespaced, err }
+	}
+
+	type args struct {
+		policy     *kconfig.NamespacePolicy
+		manifest   []byte
+		namespaced test.MockIsObjectNamespacedFn
+	}
+	type want struct {
+		namespace string
+		err       error
+		violation bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoPolicy": {
+			reason: "The namespace of the manifest should be used as is without a namespace policy.",
+			args: args{
+				manifest: configMap("elsewhere"),
+			},
+			want: want{namespace: "elsewhere"},
+		},
+		"Inherit": {
+			reason: "A namespace-scoped manifest without a namespace should inherit the namespace of its Object.",
+			args: args{
+				policy:     &kconfig.NamespacePolicy{InheritObjectNamespace: true},
+				manifest:   configMap(""),
+				namespaced: namespaced(true, nil),
+			},
+			want: want{namespace: testNamespace},
+		},
+		"InheritClusterScoped": {
+			reason: "A cluster-scoped manifest should not inherit the namespace of its Object.",
+			args: args{
+				policy:     &kconfig.NamespacePolicy{InheritObjectNamespace: true},
+				manifest:   externalResourceRaw,
+				namespaced: namespaced(false, nil),
+			},
+			want: want{namespace: ""},
+		},
+		"InheritScopeUnknown": {
+			reason: "An error determining the scope of the manifest should be returned.",
+			args: args{
+				policy:     &kconfig.NamespacePolicy{InheritObjectNamespace: true},
+				manifest:   configMap(""),
+				namespaced: namespaced(false, errBoom),
+			},
+			want: want{err: errors.Wrap(errBoom, errGetManifestScope)},
+		},
+		"Mapped": {
+			reason: "A manifest in the namespace of its Object should be written into the mapped namespace.",
+			args: args{
+				policy: &kconfig.NamespacePolicy{Mappings: []kconfig.NamespaceMapping{
+					{From: testNamespace, To: "remote"},
+				}},
+				manifest: configMap(testNamespace),
+			},
+			want: want{namespace: "remote"},
+		},
+		"MappedOmitted": {
+			reason: "A namespace-scoped manifest without a namespace should be written into the mapped namespace.",
+			args: args{
+				policy: &kconfig.NamespacePolicy{Mappings: []kconfig.NamespaceMapping{
+					{From: testNamespace, To: "remote"},
+				}},
+				manifest:   configMap(""),
+				namespaced: namespaced(true, nil),
+			},
+			want: want{namespace: "remote"},
+		},
+		"Allowed": {
+			reason: "A manifest in an allowed namespace should be accepted.",
+			args: args{
+				policy:   &kconfig.NamespacePolicy{AllowedNamespaces: []string{"elsewhere"}},
+				manifest: configMap("elsewhere"),
+			},
+			want: want{namespace: "elsewhere"},
+		},
+		"NotAllowed": {
+			reason: "A manifest in a namespace that is not allowed should be rejected.",
+			args: args{
+				policy:   &kconfig.NamespacePolicy{AllowedNamespaces: []string{"remote"}},
+				manifest: configMap("elsewhere"),
+			},
+			want: want{
+				err:       pcontroller.NewPolicyViolation(pcontroller.ReasonNamespaceNotAllowed, errNamespaceNotAllowed, "elsewhere"),
+				violation: true,
+			},
+		},
+		"MappedNotAllowed": {
+			reason: "The allowed namespaces should apply to the mapped namespace.",
+			args: args{
+				policy: &kconfig.NamespacePolicy{
+					Mappings:          []kconfig.NamespaceMapping{{From: testNamespace, To: "remote"}},
+					AllowedNamespaces: []string{testNamespace},
+				},
+				manifest: configMap(testNamespace),
+			},
+			want: want{
+				err:       pcontroller.NewPolicyViolation(pcontroller.ReasonNamespaceNotAllowed, errNamespaceNotAllowed, "remote"),
+				violation: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj := kubernetesObject(func(obj *objv1alpha1.Object) {
+				obj.Spec.ForProvider.Manifest.Raw = tc.args.manifest
+			})
+			e := &external{
+				client: resource.ClientApplicator{
+					Client: &test.MockClient{MockIsObjectNamespaced: tc.args.namespaced},
+				},
+				namespacePolicy: tc.args.policy,
+			}
+			got, err := e.targetManifest(context.Background(), obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.targetManifest(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.violation, isPolicyViolation(err)); diff != "" {
+				t.Errorf("\n%s\nisPolicyViolation(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.namespace, got.GetNamespace()); diff != "" {
+				t.Errorf("\n%s\ne.targetManifest(...): -want namespace, +got namespace:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
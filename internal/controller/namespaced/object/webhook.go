@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-kubernetes-m-crossplane-io-v1alpha1-object,mutating=false,failurePolicy=fail,groups=kubernetes.m.crossplane.io,resources=objects,versions=v1alpha1,name=objects.kubernetes.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// SetupWebhook adds a validating webhook for Object managed resources.
+func SetupWebhook(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.Object{}).
+		WithValidator(&validator{kube: mgr.GetClient(), namespaces: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// validator rejects Objects that the controller would fail to reconcile
+// because of a malformed manifest, reference or connection detail, or a
+// ClusterProviderConfig that may not be used in their namespace.
+type validator struct {
+	kube client.Reader
+	// namespaces reads the namespaces that ClusterProviderConfigs select
+	// by label. Namespaces are not cached, so it reads from the API server.
+	namespaces client.Reader
+}
+
+var _ admission.Validator[*v1alpha1.Object] = &validator{}
+
+// ValidateCreate validates a new Object.
+func (v *validator) ValidateCreate(ctx context.Context, obj *v1alpha1.Object) (admission.Warnings, error) {
+	warnings, errs := v.validate(ctx, obj)
+	return warnings, invalid(obj, errs)
+}
+
+// ValidateUpdate validates an Object whose spec changed. Updates that leave
+// the spec untouched, e.g. finalizer removal during deletion, are always
+// admitted so that Objects created before the webhook existed are not stuck.
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj *v1alpha1.Object) (admission.Warnings, error) {
+	if meta.WasDeleted(newObj) || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	warnings, errs := v.validate(ctx, newObj)
+	return warnings, invalid(newObj, errs)
+}
+
+// ValidateDelete admits every deletion.
+func (v *validator) ValidateDelete(_ context.Context, _ *v1alpha1.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, obj *v1alpha1.Object) (admission.Warnings, field.ErrorList) {
+	warnings, errs := validateObject(obj)
+	return warnings, append(errs, pcontroller.ValidateProviderConfigReference(ctx, v.kube, v.namespaces, obj.Spec.ProviderConfigReference, obj.GetNamespace(), field.NewPath("spec", "providerConfigRef"))...)
+}
+
+func invalid(obj *v1alpha1.Object, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(schema.GroupKind{Group: v1alpha1.Group, Kind: v1alpha1.ObjectKind}, obj.GetName(), errs)
+}
+
+// validateObject runs the structural checks of the manifest, references and
+// connection details of the supplied Object. The manifest is decoded with
+// parseManifest, exactly as the controller does at reconcile time. Shapes
+// that the controller accepts but partly ignores are returned as warnings.
+func validateObject(obj *v1alpha1.Object) (admission.Warnings, field.ErrorList) {
+	errs := field.ErrorList{}
+
+	mp := field.NewPath("spec", "forProvider", "manifest")
+	m, err := parseManifest(obj)
+	if err != nil {
+		errs = append(errs, field.Invalid(mp, field.OmitValueType{}, err.Error()))
+	} else {
+		errs = append(errs, pcontroller.ValidateManifest(m, mp)...)
+	}
+
+	lp := field.NewPath("spec", "forProvider", "lateInitializePaths")
+	for i, p := range obj.Spec.ForProvider.LateInitializePaths {
+		errs = append(errs, pcontroller.ValidateFieldPath(p, lp.Index(i))...)
+	}
+
+	var warnings admission.Warnings
+	rp := field.NewPath("spec", "references")
+	for i, ref := range obj.Spec.References {
+		w, e := validateReference(ref, rp.Index(i))
+		warnings = append(warnings, w...)
+		errs = append(errs, e...)
+	}
+
+	cp := field.NewPath("spec", "connectionDetails")
+	for i, cd := range obj.Spec.ConnectionDetails {
+		errs = append(errs, validateConnectionDetail(cd, cp.Index(i))...)
+	}
+
+	return warnings, errs
+}
+
+// validateReference returns warnings for the shapes of the supplied reference
+// that the controller accepts but partly ignores, and errors for those it
+// cannot resolve.
+func validateReference(ref v1alpha1.Reference, fldPath *field.Path) (admission.Warnings, field.ErrorList) {
+	var warnings admission.Warnings
+	errs := field.ErrorList{}
+
+	switch {
+	case ref.DependsOn == nil && ref.PatchesFrom == nil:
+		return append(warnings, fldPath.String()+": neither dependsOn nor patchesFrom is set, the reference is ignored"), errs
+	case ref.PatchesFrom == nil:
+		errs = append(errs, validateDependsOn(*ref.DependsOn, fldPath.Child("dependsOn"))...)
+		if ref.ToFieldPath != nil {
+			warnings = append(warnings, fldPath.Child("toFieldPath").String()+": toFieldPath is ignored without patchesFrom")
+		}
+		return warnings, errs
+	case ref.DependsOn != nil:
+		warnings = append(warnings, fldPath.Child("dependsOn").String()+": dependsOn is ignored because patchesFrom is set")
+	}
+
+	pp := fldPath.Child("patchesFrom")
+	errs = append(errs, validateDependsOn(ref.PatchesFrom.DependsOn, pp)...)
+	if ref.PatchesFrom.FieldPath == nil {
+		warnings = append(warnings, pp.Child("fieldPath").String()+": fieldPath is not set, the reference only declares a dependency")
+	} else {
+		errs = append(errs, pcontroller.ValidateFieldPath(*ref.PatchesFrom.FieldPath, pp.Child("fieldPath"))...)
+	}
+	if ref.ToFieldPath != nil {
+		errs = append(errs, pcontroller.ValidateFieldPath(*ref.ToFieldPath, fldPath.Child("toFieldPath"))...)
+	}
+
+	return warnings, errs
+}
+
+func validateDependsOn(d v1alpha1.DependsOn, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if d.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), "name of the referenced resource must be set"))
+	}
+	if d.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), "kind of the referenced resource must be set"))
+	}
+	gv, err := schema.ParseGroupVersion(d.APIVersion)
+	switch {
+	case d.APIVersion == "":
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), "apiVersion of the referenced resource must be set"))
+	case err != nil:
+		errs = append(errs, field.Invalid(fldPath.Child("apiVersion"), d.APIVersion, err.Error()))
+	}
+	return append(errs, pcontroller.ValidateNamespace(gv.WithKind(d.Kind).GroupKind(), d.Namespace, fldPath.Child("namespace"))...)
+}
+
+func validateConnectionDetail(cd v1alpha1.ConnectionDetail, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if cd.APIVersion == "" {
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), "apiVersion of the source resource must be set"))
+	}
+	if cd.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), "kind of the source resource must be set"))
+	}
+	if cd.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), "name of the source resource must be set"))
+	}
+	if cd.ToConnectionSecretKey == "" {
+		errs = append(errs, field.Required(fldPath.Child("toConnectionSecretKey"), "key in the connection secret must be set"))
+	}
+	return append(errs, pcontroller.ValidateFieldPath(cd.FieldPath, fldPath.Child("fieldPath"))...)
+}
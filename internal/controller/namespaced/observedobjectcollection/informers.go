@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package observedobjectcollection
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	kcache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	observedobjectcollectionv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/observedobjectcollection/v1alpha1"
+)
+
+const (
+	// collectionGVKsIndex is an index of all GroupKinds that are watched by
+	// a collection.
+	collectionGVKsIndex = "collectionsGVKs"
+)
+
+// namespaceGVK is the kind watched by collections that select namespaces by
+// their labels.
+var namespaceGVK = corev1.SchemeGroupVersion.WithKind("Namespace")
+
+var _ client.IndexerFunc = IndexByProviderGVK
+
+// IndexByProviderGVK assumes the passed object is an ObservedObjectCollection.
+// It returns keys with "ProviderConfig + GVK" for the kind of every criteria
+// of the collection, if it is watched.
+func IndexByProviderGVK(o client.Object) []string {
+	c, ok := o.(*observedobjectcollectionv1alpha1.ObservedObjectCollection)
+	if !ok || !c.Spec.Watch {
+		return nil
+	}
+
+	gvks := watchedKinds(c)
+	keys := make([]string, 0, len(gvks))
+	for _, gvk := range gvks {
+		keys = append(keys, refKeyProviderGVK(providerConfigRefKey(c), gvk))
+	}
+	return keys
+}
+
+// watchedKinds returns the kinds a watched collection watches, i.e. the kind
+// of every criteria, and Namespaces if any criteria selects namespaces by
+// their labels.
+func watchedKinds(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) []schema.GroupVersionKind {
+	criteria := c.Spec.Criteria()
+	gvks := make([]schema.GroupVersionKind, 0, len(criteria)+1)
+	namespaces := false
+	for _, cr := range criteria {
+		gvks = append(gvks, schema.FromAPIVersionAndKind(cr.APIVersion, cr.Kind))
+		namespaces = namespaces || cr.NamespaceSelector != nil
+	}
+	if namespaces {
+		gvks = append(gvks, namespaceGVK)
+	}
+	return gvks
+}
+
+func refKeyProviderGVK(providerConfig string, gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s.%s.%s.%s", providerConfig, gvk.Kind, gvk.Group, gvk.Version)
+}
+
+func providerConfigRefKey(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) string {
+	return fmt.Sprintf("%s/%s/%s", c.Spec.ProviderConfigReference.Kind, c.GetNamespace(), c.Spec.ProviderConfigReference.Name)
+}
+
+// matchedInformers manages informers of the kinds watched by collections. It
+// serves as an event source for realtime notifications of changed objects of
+// those kinds, with the collection reconciler as sink. It keeps informers
+// alive as long as there are collections watching their kind.
+type matchedInformers struct {
+	log              logging.Logger
+	collectionsCache cache.Cache
+
+	lock sync.RWMutex // everything below is protected by this lock
+	// queue of the collection reconciler, once started.
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request]
+	// resourceCaches holds the resource caches. These are dynamically
+	// started and stopped based on the collections watching them.
+	resourceCaches map[string]resourceCache
+}
+
+type resourceCache struct {
+	cache    cache.Cache
+	cancelFn context.CancelFunc
+}
+
+var _ source.Source = &matchedInformers{}
+
+// Start implements source.Source, i.e. starting matchedInformers as source
+// with q as the sink of change events.
+func (i *matchedInformers) Start(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.queue != nil {
+		return errors.New("source already started, cannot start it again")
+	}
+	i.queue = q
+
+	go func() {
+		<-ctx.Done()
+		i.lock.Lock()
+		i.queue = nil
+		i.lock.Unlock()
+	}()
+
+	return nil
+}
+
+// WatchResources starts informers for the given GVKs of the cluster of the
+// given provider config. It is called on every reconcile of a watched
+// collection.
+func (i *matchedInformers) WatchResources(rc *rest.Config, providerConfig string, gvks ...schema.GroupVersionKind) {
+	for _, gvk := range gvks {
+		k := refKeyProviderGVK(providerConfig, gvk)
+		i.lock.RLock()
+		_, found := i.resourceCaches[k]
+		i.lock.RUnlock()
+		if found {
+			continue
+		}
+
+		log := i.log.WithValues("providerConfig", providerConfig, "gvk", gvk.String())
+
+		ca, err := cache.New(rc, cache.Options{
+			DefaultTransform: cache.TransformStripManagedFields(),
+			DefaultWatchErrorHandler: func(_ context.Context, r *kcache.Reflector, err error) {
+				if errors.Is(err, io.EOF) {
+					// Watch closed normally.
+					return
+				}
+				log.Debug("Watch error - probably remote cluster api is gone", "error", err)
+			},
+		})
+		if err != nil {
+			log.Debug("failed creating a cache", "error", err)
+			continue
+		}
+
+		// don't forget to call cancelFn in error cases to avoid leaks. In the
+		// happy case it's called from the go routine starting the cache below.
+		ctx, cancelFn := context.WithCancel(context.Background())
+
+		// Only the metadata of the watched objects is cached.
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+		inf, err := ca.GetInformer(ctx, obj, cache.BlockUntilSynced(false)) // don't block. We wait in the go routine below.
+		if err != nil {
+			cancelFn()
+			log.Debug("failed getting informer", "error", err)
+			continue
+		}
+
+		if _, err := inf.AddEventHandler(kcache.ResourceEventHandlerFuncs{
+			AddFunc: func(_ any) {
+				i.enqueueCollections(ctx, providerConfig, gvk)
+			},
+			UpdateFunc: func(oldObj, newObj any) {
+				if membershipMayChange(oldObj.(client.Object), newObj.(client.Object)) {
+					i.enqueueCollections(ctx, providerConfig, gvk)
+				}
+			},
+			DeleteFunc: func(_ any) {
+				i.enqueueCollections(ctx, providerConfig, gvk)
+			},
+		}); err != nil {
+			cancelFn()
+			log.Debug("failed adding event handler", "error", err)
+			continue
+		}
+
+		i.lock.Lock()
+		if _, ok := i.resourceCaches[k]; ok {
+			// Another goroutine already started the cache in parallel. We
+			// should cancel the new one.
+			cancelFn()
+			i.lock.Unlock()
+			continue
+		}
+		i.resourceCaches[k] = resourceCache{
+			cache:    ca,
+			cancelFn: cancelFn,
+		}
+		i.lock.Unlock()
+
+		go func() {
+			defer cancelFn()
+
+			log.Info("Starting resource watch")
+			_ = ca.Start(ctx)
+		}()
+	}
+}
+
+// membershipMayChange returns true if an update of a watched object may
+// change whether it matches a collection. Updates of the status of an object
+// are left to the poll interval of the collection.
+func membershipMayChange(oldObj, newObj client.Object) bool {
+	return oldObj.GetGeneration() != newObj.GetGeneration() ||
+		!equality.Semantic.DeepEqual(oldObj.GetLabels(), newObj.GetLabels()) ||
+		!equality.Semantic.DeepEqual(oldObj.GetOwnerReferences(), newObj.GetOwnerReferences()) ||
+		!equality.Semantic.DeepEqual(oldObj.GetDeletionTimestamp(), newObj.GetDeletionTimestamp())
+}
+
+// enqueueCollections enqueues the collections that watch the supplied kind
+// of the cluster of the supplied provider config.
+func (i *matchedInformers) enqueueCollections(ctx context.Context, providerConfig string, gvk schema.GroupVersionKind) {
+	i.lock.RLock()
+	q := i.queue
+	i.lock.RUnlock()
+	if q == nil {
+		return
+	}
+
+	k := refKeyProviderGVK(providerConfig, gvk)
+	l := &observedobjectcollectionv1alpha1.ObservedObjectCollectionList{}
+	if err := i.collectionsCache.List(ctx, l, client.MatchingFields{collectionGVKsIndex: k}); err != nil {
+		i.log.Debug("cannot list collections watching a changed resource", "error", err, "fieldSelector", collectionGVKsIndex+"="+k)
+		return
+	}
+	for _, c := range l.Items {
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: c.GetName(), Namespace: c.GetNamespace()}})
+	}
+}
+
+// cleanupResourceInformers garbage collects informers of kinds that are no
+// longer watched by any collection.
+func (i *matchedInformers) cleanupResourceInformers(ctx context.Context) {
+	// copy map to avoid locking it for the entire duration of the loop
+	i.lock.RLock()
+	resourceCaches := make(map[string]resourceCache, len(i.resourceCaches))
+	for k, ca := range i.resourceCaches {
+		resourceCaches[k] = ca
+	}
+	i.lock.RUnlock()
+
+	i.log.Debug("Running garbage collection for resource informers", "count", len(resourceCaches))
+	for k, ca := range resourceCaches {
+		l := &observedobjectcollectionv1alpha1.ObservedObjectCollectionList{}
+		if err := i.collectionsCache.List(ctx, l, client.MatchingFields{collectionGVKsIndex: k}); err != nil {
+			i.log.Debug("cannot list collections watching a certain resource GVK", "error", err, "fieldSelector", collectionGVKsIndex+"="+k)
+			continue
+		}
+		if len(l.Items) > 0 {
+			continue
+		}
+
+		ca.cancelFn()
+		i.log.Info("Stopped resource watch", "key", k)
+		i.lock.Lock()
+		delete(i.resourceCaches, k)
+		i.lock.Unlock()
+	}
+}
@@ -19,21 +19,40 @@ package observedobjectcollection
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	xperrors "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
@@ -44,6 +63,8 @@ import (
 	objectv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
 	observedobjectcollectionv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/observedobjectcollection/v1alpha1"
 	apisv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+	"github.com/crossplane-contrib/provider-kubernetes/internal/features"
 	kubeclient "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/client"
 	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
 )
@@ -52,8 +73,41 @@ const (
 	errGetProviderConfig          = "cannot get provider config"
 	errBuildKubeForProviderConfig = "cannot build kube client for provider config"
 	errStatusUpdate               = "cannot update status"
+	errAddFinalizer               = "cannot add finalizer"
+	errRemoveFinalizer            = "cannot remove finalizer"
+	errEvalFilter                 = "not removing members that no longer match"
+	collectionFinalizer           = "kubernetes.crossplane.io/collection-members"
 	fieldOwner                    = client.FieldOwner("kubernetes.crossplane.io/observed-object-collection-controller")
 	membershipLabelKey            = "kubernetes.crossplane.io/owned-by-collection"
+	criteriaLabelKey              = "kubernetes.crossplane.io/collection-criteria"
+	memberHashAnnotationKey       = "kubernetes.crossplane.io/collection-member-hash"
+	adoptAnnotationKey            = "kubernetes.crossplane.io/adopt"
+	adoptedLabelKey               = "kubernetes.crossplane.io/adopted-from-collection"
+
+	// listPageSize is the number of objects listed per request to the
+	// remote cluster.
+	listPageSize = 500
+
+	// memberDeletionPollInterval is the interval at which a deleted
+	// collection checks whether its members are gone.
+	memberDeletionPollInterval = 10 * time.Second
+
+	// maxStatusMembers is the maximum number of members listed in the
+	// status of a collection.
+	maxStatusMembers = 100
+)
+
+// errMaxMembersExceeded is returned when the criteria of a collection match
+// more objects than it may have members.
+var errMaxMembersExceeded = errors.New("too many matching objects")
+
+// Event reasons.
+const (
+	reasonMemberJoined   event.Reason = "MemberJoined"
+	reasonMemberLeft     event.Reason = "MemberLeft"
+	reasonMemberOrphaned event.Reason = "MemberOrphaned"
+	reasonMemberAdopted  event.Reason = "MemberAdopted"
+	reasonFilterFailed   event.Reason = "FilterFailed"
 )
 
 // Reconciler watches for ObservedObjectCollection resources
@@ -61,9 +115,25 @@ const (
 type Reconciler struct {
 	client             client.Client
 	log                logging.Logger
+	record             event.Recorder
+	finalizer          resource.Finalizer
 	pollInterval       func() time.Duration
 	clientBuilder      kubeclient.Builder
-	observedObjectName func(collection client.Object, matchedObject client.Object) (string, error)
+	observedObjectName func(collection *observedobjectcollectionv1alpha1.ObservedObjectCollection, matchedObject client.Object) (string, error)
+	// kindObserver starts watches of the kinds of watched collections, if
+	// watches are enabled.
+	kindObserver kindObserver
+	// namespaces reads the namespaces that ClusterProviderConfigs select
+	// by label. Namespaces are not cached, so it reads from the API server.
+	namespaces client.Reader
+}
+
+// kindObserver tracks the kinds matched by collections in order to start
+// watches for them for realtime events.
+type kindObserver interface {
+	// WatchResources starts a watch of the given kinds to trigger reconciles
+	// when objects of those kinds change.
+	WatchResources(rc *rest.Config, providerConfig string, gvks ...schema.GroupVersionKind)
 }
 
 // Setup adds a controller that reconciles ObservedObjectCollection resources.
@@ -71,20 +141,47 @@ func Setup(mgr ctrl.Manager, o controller.Options, pollJitter time.Duration) err
 	name := managed.ControllerName(observedobjectcollectionv1alpha1.ObservedObjectCollectionGroupKind)
 
 	r := &Reconciler{
-		client: mgr.GetClient(),
-		log:    o.Logger,
+		client:     mgr.GetClient(),
+		namespaces: mgr.GetAPIReader(),
+		log:        o.Logger,
+		record:     event.NewAPIRecorder(mgr.GetEventRecorderFor(name)), //nolint:staticcheck // SA1019: keeping the legacy events API until crossplane-runtime's event package moves to GetEventRecorder
 		pollInterval: func() time.Duration {
 			return o.PollInterval + +time.Duration((rand.Float64()-0.5)*2*float64(pollJitter)) //nolint
 		},
+		finalizer:          resource.NewAPIFinalizer(mgr.GetClient(), collectionFinalizer),
 		clientBuilder:      kubeclient.NewIdentityAwareBuilder(mgr.GetClient()),
 		observedObjectName: observedObjectName,
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	cb := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&observedobjectcollectionv1alpha1.ObservedObjectCollection{}).
-		WithEventFilter(resource.DesiredStateChanged()).
-		Complete(ratelimiter.NewReconciler(name, xperrors.WithSilentRequeueOnConflict(r), o.GlobalRateLimiter))
+		For(&observedobjectcollectionv1alpha1.ObservedObjectCollection{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&objectv1alpha1.Object{}, handler.EnqueueRequestsFromMapFunc(collectionOfMember), builder.WithPredicates(memberReadinessChanged()))
+
+	if o.Features.Enabled(features.EnableAlphaWatches) {
+		ca := mgr.GetCache()
+		if err := ca.IndexField(context.Background(), &observedobjectcollectionv1alpha1.ObservedObjectCollection{}, collectionGVKsIndex, IndexByProviderGVK); err != nil {
+			return errors.Wrap(err, "cannot add index for collection GVKs")
+		}
+
+		i := &matchedInformers{
+			log:              o.Logger,
+			collectionsCache: ca,
+			resourceCaches:   make(map[string]resourceCache),
+		}
+		r.kindObserver = i
+
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			wait.UntilWithContext(ctx, i.cleanupResourceInformers, time.Minute)
+			return nil
+		})); err != nil {
+			return errors.Wrap(err, "cannot add cleanup matched resource informers runnable")
+		}
+
+		cb = cb.WatchesRawSource(i)
+	}
+
+	return cb.Complete(ratelimiter.NewReconciler(name, xperrors.WithSilentRequeueOnConflict(r), o.GlobalRateLimiter))
 }
 
 // SetupGated registers a controller setup function that reconciles
@@ -123,15 +220,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, err
 	}
 
-	if meta.WasDeleted(c) {
-		return ctrl.Result{}, nil
-	}
-
 	if meta.IsPaused(c) {
 		c.Status.SetConditions(xpv2.ReconcilePaused())
 		return ctrl.Result{}, errors.Wrap(r.client.Status().Update(ctx, c), errStatusUpdate)
 	}
 
+	if meta.WasDeleted(c) {
+		return r.releaseMembers(ctx, c, log)
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, c); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, errAddFinalizer)
+	}
+
 	log.Info("Reconciling")
 
 	var pcSpec kconfig.ProviderConfigSpec
@@ -147,13 +248,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		if err = r.client.Get(ctx, client.ObjectKey{Name: c.Spec.ProviderConfigReference.Name}, pc); err != nil {
 			return ctrl.Result{}, errors.Wrap(err, errGetProviderConfig)
 		}
-		pcSpec = pc.Spec
+		if err = pcontroller.CheckClusterProviderConfigAllowed(ctx, r.namespaces, pc, c.GetNamespace()); err != nil {
+			c.Status.SetConditions(xpv2.ReconcileError(err))
+			_ = r.client.Status().Update(ctx, c)
+			return ctrl.Result{}, err
+		}
+		pcSpec = pc.Spec.ProviderConfigSpec
 	default:
 		return ctrl.Result{}, errors.Errorf("unknown provider config kind: %q", c.Spec.ProviderConfigReference.Kind)
 	}
 
 	// Get client for the referenced provider config.
-	clusterClient, _, err := r.clientBuilder.KubeForProviderConfig(ctx, pcSpec)
+	clusterClient, rc, err := r.clientBuilder.KubeForProviderConfig(ctx, pcSpec)
 	if err != nil {
 		werr := errors.Wrap(err, errBuildKubeForProviderConfig)
 		c.Status.SetConditions(xpv2.ReconcileError(werr))
@@ -161,73 +267,148 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, werr
 	}
 
-	// Fetch objects based on the set GVK and selector.
-	k8sobjects := &unstructured.UnstructuredList{}
-	k8sobjects.SetAPIVersion(c.Spec.ObserveObjects.APIVersion)
-	k8sobjects.SetKind(c.Spec.ObserveObjects.Kind)
-	selector, err := metav1.LabelSelectorAsSelector(&c.Spec.ObserveObjects.Selector)
+	if r.kindObserver != nil && c.Spec.Watch {
+		r.kindObserver.WatchResources(rc, providerConfigRefKey(c), watchedKinds(c)...)
+	}
 
-	if err != nil {
-		werr := errors.Wrap(err, "error creating selector")
+	// Fetch any existing counter-part observe only Objects by collection label.
+	ml := map[string]string{membershipLabelKey: c.Name}
+	ol := &objectv1alpha1.ObjectList{}
+	if err := r.client.List(ctx, ol, client.MatchingLabels(ml), client.InNamespace(c.GetNamespace())); err != nil {
+		werr := errors.Wrapf(err, "cannot list members matching labels %v", ml)
 		c.Status.SetConditions(xpv2.ReconcileError(werr))
 		_ = r.client.Status().Update(ctx, c)
 		return ctrl.Result{}, werr
 	}
 
-	lo := client.ListOptions{LabelSelector: selector, Namespace: c.Spec.ObserveObjects.Namespace}
-	if err := clusterClient.List(ctx, k8sobjects, &lo); err != nil {
-		werr := errors.Wrapf(err, "error fetching objects for GVK %v and options %v", k8sobjects.GetObjectKind().GroupVersionKind(), lo)
+	existing := make(map[string]*objectv1alpha1.Object, len(ol.Items))
+	for i := range ol.Items {
+		existing[ol.Items[i].Name] = &ol.Items[i]
+	}
+
+	// Fetch the Objects adopted from the collection, which are no longer
+	// members.
+	al := &objectv1alpha1.ObjectList{}
+	if err := r.client.List(ctx, al, client.MatchingLabels{adoptedLabelKey: c.Name}, client.InNamespace(c.GetNamespace())); err != nil {
+		werr := errors.Wrap(err, "cannot list adopted objects")
 		c.Status.SetConditions(xpv2.ReconcileError(werr))
 		_ = r.client.Status().Update(ctx, c)
 		return ctrl.Result{}, werr
 	}
+	adopted := sets.New[string]()
+	for i := range al.Items {
+		adopted.Insert(al.Items[i].Name)
+	}
 
-	// Fetch any existing counter-part observe only Objects by collection label.
-	ml := map[string]string{membershipLabelKey: c.Name}
-	ol := &objectv1alpha1.ObjectList{}
-	if err := r.client.List(ctx, ol, client.MatchingLabels(ml), client.InNamespace(c.GetNamespace())); err != nil {
-		werr := errors.Wrapf(err, "cannot list members matching labels %v", ml)
+	md, err := compileMetadataTemplate(c.Spec.Template)
+	if err != nil {
+		werr := errors.Wrap(err, "cannot compile object template")
 		c.Status.SetConditions(xpv2.ReconcileError(werr))
 		_ = r.client.Status().Update(ctx, c)
 		return ctrl.Result{}, werr
 	}
 
-	// Create/update observed-only Objects for all found items.
+	// Create/update observed-only Objects for all found items of each
+	// criteria.
 	refs := sets.New[observedobjectcollectionv1alpha1.ObservedObjectReference]()
-	for i := range k8sobjects.Items {
-		o := k8sobjects.Items[i]
-		log.Debug("creating observed object for the matched item", "gvk", o.GroupVersionKind(), "name", o.GetName())
-		name, err := r.observedObjectName(c, &o)
-		if err != nil {
-			werr := errors.Wrapf(err, "error generating name for observed object, matched object: %v", o)
-			c.Status.SetConditions(xpv2.ReconcileError(werr))
-			_ = r.client.Status().Update(ctx, c)
-			return ctrl.Result{}, werr
+	var members []observedobjectcollectionv1alpha1.ObservedObjectMember
+	// Members are not removed if the filter of a criteria could not be
+	// evaluated, as they might still match.
+	filterFailed := false
+	synced := xpv2.ReconcileSuccess()
+	for ci, cr := range c.Spec.Criteria() {
+		// Fetch objects based on the set GVK and selectors.
+		matched, ferr, err := matchedObjects(ctx, clusterClient, cr, md.needsObject(), remainingMembers(c, refs.Len()), log)
+		if errors.Is(err, errMaxMembersExceeded) {
+			msg := fmt.Sprintf("the collection matches more than %d objects", *c.Spec.MaxMembers)
+			c.Status.SetConditions(xpv2.ReconcileError(errors.New(msg)), observedobjectcollectionv1alpha1.MaxMembersExceeded(msg))
+			return ctrl.Result{RequeueAfter: r.requeueAfter(c)}, errors.Wrap(r.client.Status().Update(ctx, c), errStatusUpdate)
 		}
-
-		// Create patch
-		po, err := observedObjectPatch(name, o, c)
 		if err != nil {
-			werr := errors.Wrapf(err, "error generating patch for matched object %v", o)
-			c.Status.SetConditions(xpv2.ReconcileError(werr))
+			c.Status.SetConditions(xpv2.ReconcileError(err))
 			_ = r.client.Status().Update(ctx, c)
-			return ctrl.Result{}, werr
+			return ctrl.Result{}, err
 		}
-		if err := r.client.Patch(ctx, po, client.Apply, fieldOwner, client.ForceOwnership); err != nil { //nolint:staticcheck // SA1019: keeping client.Apply until controller-runtime's Client.Apply is available on all supported paths
-			werr := errors.Wrap(err, "cannot create observed object")
-			c.Status.SetConditions(xpv2.ReconcileError(werr))
-			_ = r.client.Status().Update(ctx, c)
-			return ctrl.Result{}, werr
+		if ferr != nil {
+			ferr = errors.Wrapf(ferr, "criteria %d", ci)
+			r.record.Event(c, event.Warning(reasonFilterFailed, ferr))
+			if !filterFailed {
+				filterFailed = true
+				synced = xpv2.ReconcileError(errors.Wrap(ferr, errEvalFilter))
+			}
 		}
 
-		log.Debug("created observed object", "name", po.GetName())
-		refs.Insert(observedobjectcollectionv1alpha1.ObservedObjectReference{Name: name})
+		for i := range matched {
+			o := matched[i]
+			log.Debug("creating observed object for the matched item", "gvk", o.GroupVersionKind(), "name", o.GetName())
+			name, err := r.observedObjectName(c, &o)
+			if err != nil {
+				werr := errors.Wrapf(err, "error generating name for observed object, matched object: %v", o)
+				c.Status.SetConditions(xpv2.ReconcileError(werr))
+				_ = r.client.Status().Update(ctx, c)
+				return ctrl.Result{}, werr
+			}
+			if refs.Has(observedobjectcollectionv1alpha1.ObservedObjectReference{Name: name}) || adopted.Has(name) {
+				// Matched by an earlier criteria, or adopted.
+				continue
+			}
+
+			// Create patch
+			labels, annotations := md.render(o, log)
+			po, err := observedObjectPatch(name, ci, o, c, labels, annotations)
+			if err != nil {
+				werr := errors.Wrapf(err, "error generating patch for matched object %v", o)
+				c.Status.SetConditions(xpv2.ReconcileError(werr))
+				_ = r.client.Status().Update(ctx, c)
+				return ctrl.Result{}, werr
+			}
+			e, ok := existing[name]
+			if c.Spec.AdoptMembers || (ok && e.GetAnnotations()[adoptAnnotationKey] == "true") {
+				if err := r.adopt(ctx, clusterClient, c, e, po, o); err != nil {
+					werr := errors.Wrapf(err, "cannot adopt matched object %v", memberKey(o.GetNamespace(), o.GetName()))
+					c.Status.SetConditions(xpv2.ReconcileError(werr))
+					_ = r.client.Status().Update(ctx, c)
+					return ctrl.Result{}, werr
+				}
+				adopted.Insert(name)
+				r.record.Event(c, event.Normal(reasonMemberAdopted, fmt.Sprintf("%s %s was adopted as Object %s and left the collection", o.GetKind(), memberKey(o.GetNamespace(), o.GetName()), name)))
+				continue
+			}
+			switch {
+			case ok && e.GetAnnotations()[memberHashAnnotationKey] == po.GetAnnotations()[memberHashAnnotationKey]:
+				log.Debug("observed object is up to date", "name", po.GetName())
+			default:
+				if err := r.client.Patch(ctx, po, client.Apply, fieldOwner, client.ForceOwnership); err != nil { //nolint:staticcheck // SA1019: keeping client.Apply until controller-runtime's Client.Apply is available on all supported paths
+					werr := errors.Wrap(err, "cannot create observed object")
+					c.Status.SetConditions(xpv2.ReconcileError(werr))
+					_ = r.client.Status().Update(ctx, c)
+					return ctrl.Result{}, werr
+				}
+				log.Debug("created observed object", "name", po.GetName())
+			}
+			refs.Insert(observedobjectcollectionv1alpha1.ObservedObjectReference{Name: name})
+
+			m := observedobjectcollectionv1alpha1.ObservedObjectMember{
+				APIVersion: o.GetAPIVersion(),
+				Kind:       o.GetKind(),
+				Namespace:  o.GetNamespace(),
+				Name:       o.GetName(),
+				ObjectName: name,
+			}
+			if ok {
+				m.Ready = e.GetCondition(xpv2.TypeReady).Status == corev1.ConditionTrue
+			} else {
+				r.record.Event(c, event.Normal(reasonMemberJoined, fmt.Sprintf("%s %s joined the collection as Object %s", m.Kind, memberKey(m.Namespace, m.Name), name)))
+			}
+			members = append(members, m)
+		}
 	}
 
-	// Remove collection members that either do not exist anymore or are no match.
+	// Remove collection members that either do not exist anymore or are no
+	// match, unless the filter could not be evaluated.
 	for i := range ol.Items {
 		o := ol.Items[i]
-		if refs.Has(observedobjectcollectionv1alpha1.ObservedObjectReference{Name: o.Name}) {
+		if filterFailed || refs.Has(observedobjectcollectionv1alpha1.ObservedObjectReference{Name: o.Name}) || adopted.Has(o.Name) {
 			continue
 		}
 		log.Debug("Removing", "name", o.Name)
@@ -237,28 +418,520 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 			_ = r.client.Status().Update(ctx, c)
 			return ctrl.Result{}, werr
 		}
+		r.record.Event(c, event.Normal(reasonMemberLeft, fmt.Sprintf("Object %s left the collection", o.Name)))
 	}
-	c.Status.SetConditions(xpv2.ReconcileSuccess(), xpv2.Available())
 
 	c.Status.MembershipLabel = ml
+	setMembers(c, members)
+	c.Status.SetConditions(synced, membersReady(c), observedobjectcollectionv1alpha1.MembersTracked())
 
-	return ctrl.Result{RequeueAfter: r.pollInterval()}, r.client.Status().Update(ctx, c)
+	return ctrl.Result{RequeueAfter: r.requeueAfter(c)}, r.client.Status().Update(ctx, c)
 }
 
-func observedObjectName(collection client.Object, matchedObject client.Object) (string, error) {
+// releaseMembers deletes or orphans the members of the supplied deleted
+// collection according to its member deletion policy, and removes its
+// finalizer once no members are left.
+func (r *Reconciler) releaseMembers(ctx context.Context, c *observedobjectcollectionv1alpha1.ObservedObjectCollection, log logging.Logger) (ctrl.Result, error) {
+	if !meta.FinalizerExists(c, collectionFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	ml := map[string]string{membershipLabelKey: c.Name}
+	ol := &objectv1alpha1.ObjectList{}
+	if err := r.client.List(ctx, ol, client.MatchingLabels(ml), client.InNamespace(c.GetNamespace())); err != nil {
+		werr := errors.Wrapf(err, "cannot list members matching labels %v", ml)
+		c.Status.SetConditions(xpv2.ReconcileError(werr))
+		_ = r.client.Status().Update(ctx, c)
+		return ctrl.Result{}, werr
+	}
+
+	remaining := 0
+	for i := range ol.Items {
+		o := &ol.Items[i]
+		if c.Spec.MemberDeletionPolicy == observedobjectcollectionv1alpha1.MemberDeletionOrphan {
+			orphan(o, c)
+			if err := r.client.Update(ctx, o); err != nil {
+				werr := errors.Wrapf(err, "cannot orphan observed object %v", o.Name)
+				c.Status.SetConditions(xpv2.ReconcileError(werr))
+				_ = r.client.Status().Update(ctx, c)
+				return ctrl.Result{}, werr
+			}
+			log.Debug("Orphaned", "name", o.Name)
+			r.record.Event(c, event.Normal(reasonMemberOrphaned, fmt.Sprintf("Object %s was orphaned", o.Name)))
+			continue
+		}
+		remaining++
+		if meta.WasDeleted(o) {
+			continue
+		}
+		log.Debug("Removing", "name", o.Name)
+		if err := r.client.Delete(ctx, o); resource.IgnoreNotFound(err) != nil {
+			werr := errors.Wrapf(err, "cannot delete observed object %v", o.Name)
+			c.Status.SetConditions(xpv2.ReconcileError(werr))
+			_ = r.client.Status().Update(ctx, c)
+			return ctrl.Result{}, werr
+		}
+		r.record.Event(c, event.Normal(reasonMemberLeft, fmt.Sprintf("Object %s left the collection", o.Name)))
+	}
+
+	if remaining > 0 {
+		c.Status.MemberCount = int64(remaining)
+		c.Status.SetConditions(xpv2.ReconcileSuccess(), xpv2.Deleting().WithMessage(fmt.Sprintf("waiting for %d members to be deleted", remaining)))
+		return ctrl.Result{RequeueAfter: memberDeletionPollInterval}, errors.Wrap(r.client.Status().Update(ctx, c), errStatusUpdate)
+	}
+
+	return ctrl.Result{}, errors.Wrap(r.finalizer.RemoveFinalizer(ctx, c), errRemoveFinalizer)
+}
+
+// adopt turns the supplied member of the supplied matched object into a fully
+// managed Object, whose manifest is the current state of the matched object,
+// and detaches it from the supplied collection. The member is created from the
+// supplied patch if it does not exist yet.
+func (r *Reconciler) adopt(ctx context.Context, kube client.Client, c *observedobjectcollectionv1alpha1.ObservedObjectCollection, member *objectv1alpha1.Object, po *unstructured.Unstructured, matched unstructured.Unstructured) error {
+	remote := &unstructured.Unstructured{}
+	remote.SetGroupVersionKind(matched.GroupVersionKind())
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: matched.GetNamespace(), Name: matched.GetName()}, remote); err != nil {
+		return errors.Wrap(err, "cannot get matched object")
+	}
+	manifest, err := json.Marshal(pcontroller.CleanManifest(remote).Object)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal manifest")
+	}
+
+	o := member
+	if o == nil {
+		o = &objectv1alpha1.Object{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(po.Object, o); err != nil {
+			return errors.Wrap(err, "cannot convert from unstructured")
+		}
+	}
+	o.Spec.ForProvider.Manifest = runtime.RawExtension{Raw: manifest}
+	o.Spec.ManagementPolicies = xpv2.ManagementPolicies{xpv2.ManagementActionAll}
+	orphan(o, c)
+	meta.RemoveAnnotations(o, adoptAnnotationKey)
+	meta.AddLabels(o, map[string]string{adoptedLabelKey: c.Name})
+
+	if member == nil {
+		return errors.Wrap(r.client.Create(ctx, o), "cannot create adopted object")
+	}
+	return errors.Wrap(r.client.Update(ctx, o), "cannot update adopted object")
+}
+
+// orphan removes the owner reference to the supplied collection and the
+// membership labels from the supplied member.
+func orphan(o *objectv1alpha1.Object, c *observedobjectcollectionv1alpha1.ObservedObjectCollection) {
+	o.SetOwnerReferences(slices.DeleteFunc(o.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == c.GetUID()
+	}))
+	labels := o.GetLabels()
+	delete(labels, membershipLabelKey)
+	delete(labels, criteriaLabelKey)
+	o.SetLabels(labels)
+	annotations := o.GetAnnotations()
+	delete(annotations, memberHashAnnotationKey)
+	o.SetAnnotations(annotations)
+}
+
+// remainingMembers returns the number of further objects the supplied
+// collection may match, or -1 if it may match any number of objects.
+func remainingMembers(c *observedobjectcollectionv1alpha1.ObservedObjectCollection, members int) int {
+	if c.Spec.MaxMembers == nil {
+		return -1
+	}
+	return max(int(*c.Spec.MaxMembers)-members, 0)
+}
+
+// requeueAfter returns the poll interval of the supplied collection.
+func (r *Reconciler) requeueAfter(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) time.Duration {
+	if c.Spec.PollInterval != nil {
+		return c.Spec.PollInterval.Duration
+	}
+	return r.pollInterval()
+}
+
+// applyTemplateSpec applies the supplied template to the spec of the supplied
+// member Object of the supplied matched object.
+func applyTemplateSpec(o *objectv1alpha1.Object, t *observedobjectcollectionv1alpha1.ObservedObjectTemplateSpec, matchedObject unstructured.Unstructured) {
+	if len(t.ManagementPolicies) > 0 {
+		o.Spec.ManagementPolicies = t.ManagementPolicies
+	}
+	if t.Readiness != nil {
+		o.Spec.Readiness = *t.Readiness
+	}
+	o.Spec.Watch = t.Watch
+	for _, cd := range t.ConnectionDetails {
+		o.Spec.ConnectionDetails = append(o.Spec.ConnectionDetails, objectv1alpha1.ConnectionDetail{
+			ObjectReference: corev1.ObjectReference{
+				APIVersion: matchedObject.GetAPIVersion(),
+				Kind:       matchedObject.GetKind(),
+				Namespace:  matchedObject.GetNamespace(),
+				Name:       matchedObject.GetName(),
+				FieldPath:  cd.FieldPath,
+			},
+			ToConnectionSecretKey: cd.ToConnectionSecretKey,
+		})
+	}
+	if ref := t.WriteConnectionSecretToReference; ref != nil {
+		o.Spec.WriteConnectionSecretToReference = &xpv2.LocalSecretReference{
+			Name: connectionSecretName(ref.NamePattern, o.GetName(), matchedObject),
+		}
+	}
+}
+
+// connectionSecretName returns the name of the connection secret of the
+// supplied member Object of the supplied matched object.
+func connectionSecretName(pattern, objectName string, matchedObject unstructured.Unstructured) string {
+	if pattern == "" {
+		return objectName
+	}
+	return strings.NewReplacer(
+		"{name}", matchedObject.GetName(),
+		"{namespace}", matchedObject.GetNamespace(),
+		"{kind}", strings.ToLower(matchedObject.GetKind()),
+		"{objectName}", objectName,
+	).Replace(pattern)
+}
+
+// setMembers records the supplied members and their counts in the status of
+// the supplied collection.
+func setMembers(c *observedobjectcollectionv1alpha1.ObservedObjectCollection, members []observedobjectcollectionv1alpha1.ObservedObjectMember) {
+	slices.SortFunc(members, func(a, b observedobjectcollectionv1alpha1.ObservedObjectMember) int {
+		return strings.Compare(a.ObjectName, b.ObjectName)
+	})
+	c.Status.MemberCount = int64(len(members))
+	c.Status.ReadyMemberCount = 0
+	for _, m := range members {
+		if m.Ready {
+			c.Status.ReadyMemberCount++
+		}
+	}
+	c.Status.Members = members[:min(len(members), maxStatusMembers)]
+}
+
+// membersReady returns the Ready condition of the supplied collection, which
+// is available once all of its members are ready.
+func membersReady(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) xpv2.Condition {
+	if c.Status.ReadyMemberCount < c.Status.MemberCount {
+		return xpv2.Unavailable().WithMessage(fmt.Sprintf("%d of %d members are ready", c.Status.ReadyMemberCount, c.Status.MemberCount))
+	}
+	return xpv2.Available()
+}
+
+// memberKey returns the namespace/name key of a matched object.
+func memberKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// collectionOfMember enqueues the collection the supplied Object is a member
+// of, if any.
+func collectionOfMember(_ context.Context, o client.Object) []reconcile.Request {
+	name, ok := o.GetLabels()[membershipLabelKey]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: name}}}
+}
+
+// memberReadinessChanged accepts updates of Objects that change whether they
+// are ready, so that the readiness of their collection is kept up to date,
+// and deletions of Objects, so that deleted collections learn when their
+// members are gone.
+func memberReadinessChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(ctrlevent.CreateEvent) bool { return false },
+		DeleteFunc:  func(ctrlevent.DeleteEvent) bool { return true },
+		GenericFunc: func(ctrlevent.GenericEvent) bool { return false },
+		UpdateFunc: func(e ctrlevent.UpdateEvent) bool {
+			o, ok := e.ObjectOld.(*objectv1alpha1.Object)
+			if !ok {
+				return false
+			}
+			n, ok := e.ObjectNew.(*objectv1alpha1.Object)
+			if !ok {
+				return false
+			}
+			return o.GetCondition(xpv2.TypeReady).Status != n.GetCondition(xpv2.TypeReady).Status
+		},
+	}
+}
+
+// matchedObjects lists the objects of the remote cluster that match the
+// supplied criteria, a page at a time. Only the metadata of the objects is
+// listed, unless full is true or the criteria filter the objects. If limit is
+// not negative errMaxMembersExceeded is returned as soon as more objects
+// match. Objects the filter cannot be evaluated against do not match, and are
+// reported by the returned filter error.
+func matchedObjects(ctx context.Context, kube client.Client, cr observedobjectcollectionv1alpha1.ObserveObjectCriteria, full bool, limit int, log logging.Logger) (matched []unstructured.Unstructured, filterErr error, err error) {
+	gvk := schema.FromAPIVersionAndKind(cr.APIVersion, cr.Kind)
+	selector, err := metav1.LabelSelectorAsSelector(&cr.Selector)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating selector")
+	}
+
+	fieldSelector, err := fields.ParseSelector(cr.FieldSelector)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating field selector")
+	}
+
+	var filter *pcontroller.CELPredicate
+	if cr.Filter != "" {
+		if filter, err = pcontroller.CompileCELPredicate(cr.Filter); err != nil {
+			return nil, nil, errors.Wrap(err, "error compiling filter")
+		}
+	}
+
+	namespaces := []string{cr.Namespace}
+	if cr.NamespaceSelector != nil {
+		if namespaces, err = selectedNamespaces(ctx, kube, cr.NamespaceSelector); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	failed := 0
+	for _, ns := range namespaces {
+		lo := client.ListOptions{LabelSelector: selector, FieldSelector: fieldSelector, Namespace: ns, Limit: listPageSize}
+		for {
+			items, cont, err := listPage(ctx, kube, gvk, full || filter != nil, &lo)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "error fetching objects for GVK %v and options %v", gvk, lo)
+			}
+			for i := range items {
+				o := items[i]
+				ok, err := matchesFilters(&o, cr.OwnerSelector, filter)
+				if err != nil {
+					if filterErr == nil {
+						filterErr = errors.Wrapf(err, "%s %s", o.GetKind(), memberKey(o.GetNamespace(), o.GetName()))
+					}
+					failed++
+					continue
+				}
+				if !ok {
+					log.Debug("skipping item not matching the filters", "gvk", o.GroupVersionKind(), "name", o.GetName())
+					continue
+				}
+				if limit >= 0 && len(matched) == limit {
+					return nil, nil, errMaxMembersExceeded
+				}
+				matched = append(matched, o)
+			}
+			if cont == "" {
+				break
+			}
+			lo.Continue = cont
+		}
+	}
+	if failed > 0 {
+		filterErr = errors.Wrapf(filterErr, "cannot evaluate filter against %d objects", failed)
+	}
+	return matched, filterErr, nil
+}
+
+// selectedNamespaces returns the names of the namespaces of the remote
+// cluster that match the supplied selector.
+func selectedNamespaces(ctx context.Context, kube client.Client, s *metav1.LabelSelector) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating namespace selector")
+	}
+	l := &metav1.PartialObjectMetadataList{}
+	l.SetGroupVersionKind(namespaceGVK.GroupVersion().WithKind(namespaceGVK.Kind + "List"))
+	if err := kube.List(ctx, l, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, errors.Wrap(err, "error fetching namespaces")
+	}
+	namespaces := make([]string, 0, len(l.Items))
+	for i := range l.Items {
+		namespaces = append(namespaces, l.Items[i].GetName())
+	}
+	return namespaces, nil
+}
+
+// listPage lists a page of the objects of the supplied kind, and returns the
+// continue token of the next page, if any. Only the metadata of the objects
+// is listed unless full is true.
+func listPage(ctx context.Context, kube client.Client, gvk schema.GroupVersionKind, full bool, lo *client.ListOptions) ([]unstructured.Unstructured, string, error) {
+	if full {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(gvk)
+		if err := kube.List(ctx, l, lo); err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.GetContinue(), nil
+	}
+
+	l := &metav1.PartialObjectMetadataList{}
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := kube.List(ctx, l, lo); err != nil {
+		return nil, "", err
+	}
+	items := make([]unstructured.Unstructured, 0, len(l.Items))
+	for i := range l.Items {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&l.Items[i].ObjectMeta)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "cannot convert to unstructured")
+		}
+		u := unstructured.Unstructured{Object: map[string]any{"metadata": m}}
+		u.SetGroupVersionKind(gvk)
+		items = append(items, u)
+	}
+	return items, l.Continue, nil
+}
+
+// matchesFilters returns true if the supplied object is a child of the
+// selected owner and satisfies the filter, if any. An object the filter cannot
+// be evaluated against does not match.
+func matchesFilters(o *unstructured.Unstructured, owner *observedobjectcollectionv1alpha1.OwnerSelector, filter *pcontroller.CELPredicate) (bool, error) {
+	if owner != nil && !owner.Matches(o.GetOwnerReferences()) {
+		return false, nil
+	}
+	if filter == nil {
+		return true, nil
+	}
+	return filter.Eval(o.Object)
+}
+
+// observedObjectName returns the name of the Object observing the supplied
+// matched object. Objects of the kind of the first criteria keep the name they
+// had before collections could observe multiple kinds, and objects of other
+// kinds are told apart by their kind. The collection name is truncated so that
+// the name stays a valid object name.
+func observedObjectName(collection *observedobjectcollectionv1alpha1.ObservedObjectCollection, matchedObject client.Object) (string, error) {
+	gvk := matchedObject.GetObjectKind().GroupVersionKind()
 	// unique object identifier
-	k := fmt.Sprintf("%v/%s/%s", matchedObject.GetObjectKind().GroupVersionKind(), matchedObject.GetNamespace(), matchedObject.GetName())
+	k := fmt.Sprintf("%v/%s/%s", gvk, matchedObject.GetNamespace(), matchedObject.GetName())
 	// Compute sha256 hash of it and take first 56 bits.
 	h := sha256.New()
 	if _, err := h.Write([]byte(k)); err != nil {
 		return "", err
 	}
-	kp := fmt.Sprintf("%x", h.Sum(nil))[0:7]
-	// append it to the collection name
-	return fmt.Sprintf("%s-%s", collection.GetName(), kp), nil
+	suffix := "-" + fmt.Sprintf("%x", h.Sum(nil))[0:7]
+	if gvk != schema.FromAPIVersionAndKind(collection.Spec.ObserveObjects.APIVersion, collection.Spec.ObserveObjects.Kind) {
+		suffix = "-" + strings.ToLower(gvk.Kind) + suffix
+	}
+	prefix := collection.GetName()
+	if n := validation.DNS1123SubdomainMaxLength - len(suffix); len(prefix) > n {
+		prefix = strings.TrimRight(prefix[:n], "-.")
+	}
+	return prefix + suffix, nil
+}
+
+// A templateValue reads the value of a label or annotation from a matched
+// object.
+type templateValue struct {
+	key        string
+	fieldPath  string
+	expression *pcontroller.CELExpression
+}
+
+func compileTemplateValues(values []observedobjectcollectionv1alpha1.ObservedObjectTemplateValue) ([]templateValue, error) {
+	tvs := make([]templateValue, 0, len(values))
+	for _, v := range values {
+		tv := templateValue{key: v.Key, fieldPath: v.FieldPath}
+		if v.Expression != "" {
+			e, err := pcontroller.CompileCELExpression(v.Expression)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot compile expression of %q", v.Key)
+			}
+			tv.expression = e
+		}
+		tvs = append(tvs, tv)
+	}
+	return tvs, nil
+}
+
+// value returns the value of the supplied matched object.
+func (v templateValue) value(o unstructured.Unstructured) (string, error) {
+	if v.expression != nil {
+		return v.expression.Eval(o.Object)
+	}
+	p := fieldpath.Pave(o.Object)
+	if s, err := p.GetString(v.fieldPath); err == nil {
+		return s, nil
+	}
+	val, err := p.GetValue(v.fieldPath)
+	if err != nil {
+		return "", err
+	}
+	switch val.(type) {
+	case bool, int64, float64:
+		return fmt.Sprint(val), nil
+	}
+	return "", errors.Errorf("%s: not a string, a number or a bool", v.fieldPath)
+}
+
+// A metadataTemplate renders the labels and annotations of member Objects.
+type metadataTemplate struct {
+	labels             map[string]string
+	annotations        map[string]string
+	dynamicLabels      []templateValue
+	dynamicAnnotations []templateValue
+}
+
+// compileMetadataTemplate compiles the metadata of the supplied template,
+// which may be nil.
+func compileMetadataTemplate(t *observedobjectcollectionv1alpha1.ObservedObjectTemplate) (*metadataTemplate, error) {
+	md := &metadataTemplate{}
+	if t == nil {
+		return md, nil
+	}
+	md.labels = t.Metadata.Labels
+	md.annotations = t.Metadata.Annotations
+	var err error
+	if md.dynamicLabels, err = compileTemplateValues(t.Metadata.DynamicLabels); err != nil {
+		return nil, err
+	}
+	if md.dynamicAnnotations, err = compileTemplateValues(t.Metadata.DynamicAnnotations); err != nil {
+		return nil, err
+	}
+	return md, nil
 }
 
-func observedObjectPatch(name string, matchedObject unstructured.Unstructured, collection *observedobjectcollectionv1alpha1.ObservedObjectCollection) (*unstructured.Unstructured, error) {
+// needsObject returns true if rendering the template requires the full
+// matched objects rather than only their metadata.
+func (t *metadataTemplate) needsObject() bool {
+	return len(t.dynamicLabels) > 0 || len(t.dynamicAnnotations) > 0
+}
+
+// render returns the labels and annotations of the member Object of the
+// supplied matched object. Dynamic values that cannot be read are omitted, so
+// that the static value of the same key applies, if any.
+func (t *metadataTemplate) render(o unstructured.Unstructured, log logging.Logger) (labels, annotations map[string]string) {
+	labels = make(map[string]string, len(t.labels)+len(t.dynamicLabels))
+	for k, v := range t.labels {
+		labels[k] = v
+	}
+	for _, tv := range t.dynamicLabels {
+		v, err := tv.value(o)
+		if err == nil && len(validation.IsValidLabelValue(v)) > 0 {
+			err = errors.Errorf("%q is not a valid label value", v)
+		}
+		if err != nil {
+			log.Debug("cannot read label of member", "gvk", o.GroupVersionKind(), "name", o.GetName(), "label", tv.key, "err", err)
+			continue
+		}
+		labels[tv.key] = v
+	}
+	if len(t.annotations) == 0 && len(t.dynamicAnnotations) == 0 {
+		return labels, nil
+	}
+	annotations = make(map[string]string, len(t.annotations)+len(t.dynamicAnnotations))
+	for k, v := range t.annotations {
+		annotations[k] = v
+	}
+	for _, tv := range t.dynamicAnnotations {
+		v, err := tv.value(o)
+		if err != nil {
+			log.Debug("cannot read annotation of member", "gvk", o.GroupVersionKind(), "name", o.GetName(), "annotation", tv.key, "err", err)
+			continue
+		}
+		annotations[tv.key] = v
+	}
+	return labels, annotations
+}
+
+func observedObjectPatch(name string, criteria int, matchedObject unstructured.Unstructured, collection *observedobjectcollectionv1alpha1.ObservedObjectCollection, labels, annotations map[string]string) (*unstructured.Unstructured, error) {
 	objectManifestTemplate := `{
 "kind": "%s",
 "apiVersion": "%s",
@@ -293,17 +966,18 @@ func observedObjectPatch(name string, matchedObject unstructured.Unstructured, c
 			},
 		},
 	}
-	labels := map[string]string{
-		membershipLabelKey: collection.Name,
+	if len(annotations) > 0 {
+		observedObject.SetAnnotations(annotations)
 	}
-	if t := collection.Spec.Template; t != nil {
-		for k, v := range t.Metadata.Labels {
-			labels[k] = v
-		}
-		if len(t.Metadata.Annotations) > 0 {
-			observedObject.SetAnnotations(t.Metadata.Annotations)
-		}
+	if t := collection.Spec.Template; t != nil && t.Spec != nil {
+		applyTemplateSpec(observedObject, t.Spec, matchedObject)
+	}
+	// The membership labels take precedence over the template.
+	if labels == nil {
+		labels = map[string]string{}
 	}
+	labels[membershipLabelKey] = collection.Name
+	labels[criteriaLabelKey] = strconv.Itoa(criteria)
 	observedObject.SetLabels(labels)
 	v, err := runtime.DefaultUnstructuredConverter.ToUnstructured(observedObject)
 	if err != nil {
@@ -312,5 +986,25 @@ func observedObjectPatch(name string, matchedObject unstructured.Unstructured, c
 	u := &unstructured.Unstructured{Object: v}
 	u.SetGroupVersionKind(objectv1alpha1.ObjectGroupVersionKind)
 	u.SetName(observedObject.Name)
+	// Record a hash of the patch, so that unchanged members need not be
+	// patched again.
+	h, err := patchHash(u)
+	if err != nil {
+		return nil, err
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[memberHashAnnotationKey] = h
+	u.SetAnnotations(annotations)
 	return u, nil
 }
+
+// patchHash returns the sha256 hash of the supplied patch.
+func patchHash(u *unstructured.Unstructured) (string, error) {
+	b, err := u.MarshalJSON()
+	if err != nil {
+		return "", errors.Wrap(err, "cannot marshal patch")
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package observedobjectcollection
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	objectv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/object/v1alpha1"
+	observedobjectcollectionv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/observedobjectcollection/v1alpha1"
+	pcontroller "github.com/crossplane-contrib/provider-kubernetes/internal/controller"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-kubernetes-m-crossplane-io-v1alpha1-observedobjectcollection,mutating=false,failurePolicy=fail,groups=kubernetes.m.crossplane.io,resources=observedobjectcollections,versions=v1alpha1,name=observedobjectcollections.kubernetes.m.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// SetupWebhook adds a validating webhook for ObservedObjectCollection
+// resources.
+func SetupWebhook(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &observedobjectcollectionv1alpha1.ObservedObjectCollection{}).
+		WithValidator(&validator{kube: mgr.GetClient(), namespaces: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// validator rejects ObservedObjectCollections that the controller would fail
+// to reconcile.
+type validator struct {
+	kube client.Reader
+	// namespaces reads the namespaces that ClusterProviderConfigs select
+	// by label. Namespaces are not cached, so it reads from the API server.
+	namespaces client.Reader
+}
+
+var _ admission.Validator[*observedobjectcollectionv1alpha1.ObservedObjectCollection] = &validator{}
+
+// ValidateCreate validates a new ObservedObjectCollection.
+func (v *validator) ValidateCreate(ctx context.Context, c *observedobjectcollectionv1alpha1.ObservedObjectCollection) (admission.Warnings, error) {
+	return warnings(c), invalid(c, v.validate(ctx, c))
+}
+
+// ValidateUpdate validates an ObservedObjectCollection whose spec changed.
+func (v *validator) ValidateUpdate(ctx context.Context, oldC, newC *observedobjectcollectionv1alpha1.ObservedObjectCollection) (admission.Warnings, error) {
+	if meta.WasDeleted(newC) || equality.Semantic.DeepEqual(oldC.Spec, newC.Spec) {
+		return nil, nil
+	}
+	return warnings(newC), invalid(newC, v.validate(ctx, newC))
+}
+
+// ValidateDelete admits every deletion.
+func (v *validator) ValidateDelete(_ context.Context, _ *observedobjectcollectionv1alpha1.ObservedObjectCollection) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) validate(ctx context.Context, c *observedobjectcollectionv1alpha1.ObservedObjectCollection) field.ErrorList {
+	errs := validateCollection(c)
+	return append(errs, pcontroller.ValidateProviderConfigReference(ctx, v.kube, v.namespaces, &c.Spec.ProviderConfigReference, c.GetNamespace(), field.NewPath("spec", "providerConfigRef"))...)
+}
+
+func invalid(c *observedobjectcollectionv1alpha1.ObservedObjectCollection, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return kerrors.NewInvalid(schema.GroupKind{Group: observedobjectcollectionv1alpha1.Group, Kind: observedobjectcollectionv1alpha1.ObservedObjectCollectionKind}, c.GetName(), errs)
+}
+
+func warnings(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) admission.Warnings {
+	var w admission.Warnings
+	if c.Spec.AdoptMembers {
+		w = append(w, "spec.adoptMembers: matched objects will be fully managed, including their deletion, and leave the collection")
+	}
+	t := c.Spec.Template
+	if t == nil {
+		return w
+	}
+	for _, k := range []string{membershipLabelKey, criteriaLabelKey} {
+		if _, ok := t.Metadata.Labels[k]; ok {
+			w = append(w, fmt.Sprintf("spec.objectTemplate.metadata.labels: %s is set by the controller and will be overridden", k))
+		}
+		for _, v := range t.Metadata.DynamicLabels {
+			if v.Key == k {
+				w = append(w, fmt.Sprintf("spec.objectTemplate.metadata.dynamicLabels: %s is set by the controller and will be overridden", k))
+			}
+		}
+	}
+	if t.Spec != nil {
+		for _, p := range t.Spec.ManagementPolicies {
+			if p == xpv2.ManagementActionAll || p == xpv2.ManagementActionDelete {
+				w = append(w, "spec.objectTemplate.spec.managementPolicies: the matched object of a member that leaves the collection will be deleted")
+				break
+			}
+		}
+	}
+	return w
+}
+
+// validateCollection runs the structural checks of the membership criteria
+// and the Object template of the supplied collection.
+func validateCollection(c *observedobjectcollectionv1alpha1.ObservedObjectCollection) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validateCriteria(&c.Spec.ObserveObjects, field.NewPath("spec", "observeObjects"))...)
+	for i := range c.Spec.AdditionalObserveObjects {
+		errs = append(errs, validateCriteria(&c.Spec.AdditionalObserveObjects[i], field.NewPath("spec", "additionalObserveObjects").Index(i))...)
+	}
+
+	if p := c.Spec.PollInterval; p != nil && p.Duration <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "pollInterval"), p.Duration.String(), "must be positive"))
+	}
+
+	if t := c.Spec.Template; t != nil {
+		mp := field.NewPath("spec", "objectTemplate", "metadata")
+		errs = append(errs, metav1validation.ValidateLabels(t.Metadata.Labels, mp.Child("labels"))...)
+		errs = append(errs, apivalidation.ValidateAnnotations(t.Metadata.Annotations, mp.Child("annotations"))...)
+		errs = append(errs, validateTemplateValues(t.Metadata.DynamicLabels, mp.Child("dynamicLabels"))...)
+		errs = append(errs, validateTemplateValues(t.Metadata.DynamicAnnotations, mp.Child("dynamicAnnotations"))...)
+		if t.Spec != nil {
+			errs = append(errs, validateTemplateSpec(t.Spec, field.NewPath("spec", "objectTemplate", "spec"))...)
+		}
+	}
+
+	return errs
+}
+
+// validateTemplateValues runs the structural checks of the supplied dynamic
+// labels or annotations.
+func validateTemplateValues(values []observedobjectcollectionv1alpha1.ObservedObjectTemplateValue, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for i, v := range values {
+		p := fldPath.Index(i)
+		for _, msg := range validation.IsQualifiedName(v.Key) {
+			errs = append(errs, field.Invalid(p.Child("key"), v.Key, msg))
+		}
+		if (v.FieldPath == "") == (v.Expression == "") {
+			errs = append(errs, field.Invalid(p, v.Key, "exactly one of fieldPath and expression must be set"))
+			continue
+		}
+		if v.FieldPath != "" {
+			if _, err := fieldpath.Parse(v.FieldPath); err != nil {
+				errs = append(errs, field.Invalid(p.Child("fieldPath"), v.FieldPath, err.Error()))
+			}
+		}
+		if v.Expression != "" {
+			if _, err := pcontroller.CompileCELExpression(v.Expression); err != nil {
+				errs = append(errs, field.Invalid(p.Child("expression"), v.Expression, err.Error()))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateTemplateSpec runs the structural checks of the supplied template of
+// the spec of member Objects.
+func validateTemplateSpec(t *observedobjectcollectionv1alpha1.ObservedObjectTemplateSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r := t.Readiness; r != nil && r.Policy == objectv1alpha1.ReadinessPolicyDeriveFromCelQuery {
+		if _, err := pcontroller.CompileCELPredicate(r.CelQuery); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("readiness", "celQuery"), r.CelQuery, err.Error()))
+		}
+	}
+	if ref := t.WriteConnectionSecretToReference; ref != nil {
+		// Every placeholder is replaced by a valid name, so that only the
+		// literal parts of the pattern are checked.
+		name := connectionSecretName(ref.NamePattern, "a", unstructured.Unstructured{Object: map[string]any{
+			"kind":     "a",
+			"metadata": map[string]any{"name": "a", "namespace": "a"},
+		}})
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			errs = append(errs, field.Invalid(fldPath.Child("writeConnectionSecretToRef", "namePattern"), ref.NamePattern, msg))
+		}
+	}
+
+	return errs
+}
+
+// validateCriteria runs the structural checks of the supplied membership
+// criteria.
+func validateCriteria(cr *observedobjectcollectionv1alpha1.ObserveObjectCriteria, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	gv, err := schema.ParseGroupVersion(cr.APIVersion)
+	if err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("apiVersion"), cr.APIVersion, err.Error()))
+	}
+	gk := gv.WithKind(cr.Kind).GroupKind()
+	errs = append(errs, pcontroller.ValidateNamespace(gk, cr.Namespace, fldPath.Child("namespace"))...)
+	if cr.NamespaceSelector != nil {
+		switch {
+		case cr.Namespace != "":
+			errs = append(errs, field.Forbidden(fldPath.Child("namespaceSelector"), "namespace and namespaceSelector are mutually exclusive"))
+		case pcontroller.IsClusterScopedKind(gk):
+			errs = append(errs, field.Forbidden(fldPath.Child("namespaceSelector"), fmt.Sprintf("%s is cluster-scoped and must not select namespaces", gk.String())))
+		}
+		errs = append(errs, validateSelector(cr.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+	errs = append(errs, validateSelector(&cr.Selector, fldPath.Child("selector"))...)
+	if _, err := fields.ParseSelector(cr.FieldSelector); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("fieldSelector"), cr.FieldSelector, err.Error()))
+	}
+	if cr.Filter != "" {
+		if _, err := pcontroller.CompileCELPredicate(cr.Filter); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("filter"), cr.Filter, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+// validateSelector converts the selector exactly as the controller does
+// before listing matching objects.
+func validateSelector(s *metav1.LabelSelector, fldPath *field.Path) field.ErrorList {
+	errs := metav1validation.ValidateLabelSelector(s, metav1validation.LabelSelectorValidationOptions{}, fldPath)
+	if len(errs) > 0 {
+		return errs
+	}
+	if _, err := metav1.LabelSelectorAsSelector(s); err != nil {
+		errs = append(errs, field.Invalid(fldPath, field.OmitValueType{}, err.Error()))
+	}
+	return errs
+}
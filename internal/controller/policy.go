@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+const (
+	errKindNotAllowed           = "kind %s is not allowed by the guardrails of the provider config"
+	errNamespaceNotAllowed      = "namespace %q of the target cluster is not allowed by the guardrails of the provider config"
+	errClusterScopedNotAllowed  = "cluster-scoped kind %s is not allowed by the guardrails of the provider config"
+	errMaxObjectsExceeded       = "the provider config may be used by at most %d resources"
+	errGetManifestScope         = "cannot determine whether the manifest is namespace-scoped"
+	errUpdatePolicyViolations   = "cannot update policy violations of the provider config"
+	errProviderConfigNotAllowed = "ClusterProviderConfig %s may not be used by resources in namespace %s"
+	errGetNamespace             = "cannot get namespace"
+	errGetNamespaceForbidden    = "cannot get namespace to match the allowed namespaces selector of ClusterProviderConfig %s: the provider must be granted permission to get namespaces"
+	errAllowedNamespaces        = "cannot evaluate allowed namespaces of ClusterProviderConfig"
+	errValidationRuleFailed     = "manifest violates validation rule %q of the provider config: %s"
+	errValidationRuleInvalid    = "validation rule %q of the provider config is invalid: %v"
+	errValidationRuleEval       = "validation rule %q of the provider config cannot be evaluated: %v"
+)
+
+// maxRecordedPolicyViolations is the maximum number of policy violations
+// listed in the status of a provider config, which keeps its size bounded no
+// matter how many resources violate its policies.
+const maxRecordedPolicyViolations = 100
+
+// validationRuleCacheSize is the number of compiled validation rules that are
+// kept, so that rules are not compiled again on every reconcile.
+const validationRuleCacheSize = 256
+
+// compiledValidationRules caches compiled validation rules by their text.
+var compiledValidationRules = lru.New(validationRuleCacheSize)
+
+// A compiledValidationRule is a validation rule compiled to a predicate, or
+// the error compiling it.
+type compiledValidationRule struct {
+	predicate *CELPredicate
+	err       error
+}
+
+// Reasons a manifest violates a policy of its provider config.
+const (
+	ReasonNamespaceNotAllowed      xpv2.ConditionReason = "NamespaceNotAllowed"
+	ReasonKindNotAllowed           xpv2.ConditionReason = "KindNotAllowed"
+	ReasonClusterScopedNotAllowed  xpv2.ConditionReason = "ClusterScopedKindNotAllowed"
+	ReasonMaxObjectsExceeded       xpv2.ConditionReason = "MaxObjectsExceeded"
+	ReasonProviderConfigNotAllowed xpv2.ConditionReason = "ProviderConfigNotAllowed"
+	ReasonValidationRuleFailed     xpv2.ConditionReason = "ValidationRuleFailed"
+	ReasonValidationRuleInvalid    xpv2.ConditionReason = "ValidationRuleInvalid"
+)
+
+// A PolicyViolation is returned when a manifest violates a policy of its
+// provider config.
+type PolicyViolation struct {
+	Reason  xpv2.ConditionReason
+	Message string
+}
+
+// Error returns the message of the violation.
+func (v *PolicyViolation) Error() string {
+	return v.Message
+}
+
+// Is reports whether target is a violation with the same reason and message.
+func (v *PolicyViolation) Is(target error) bool {
+	t, ok := target.(*PolicyViolation)
+	return ok && t.Reason == v.Reason && t.Message == v.Message
+}
+
+// NewPolicyViolation returns a violation for the supplied reason.
+func NewPolicyViolation(r xpv2.ConditionReason, format string, args ...any) *PolicyViolation {
+	return &PolicyViolation{Reason: r, Message: fmt.Sprintf(format, args...)}
+}
+
+// AsPolicyViolation returns the policy violation the supplied error is, or
+// wraps, if any.
+func AsPolicyViolation(err error) (*PolicyViolation, bool) {
+	var v *PolicyViolation
+	ok := errors.As(err, &v)
+	return v, ok
+}
+
+// CheckGuardrails returns a PolicyViolation if the supplied manifest violates
+// the supplied guardrails. The supplied function tells whether the manifest
+// is namespace-scoped. It is only called if the guardrails deny
+// cluster-scoped kinds.
+func CheckGuardrails(g *kconfig.Guardrails, manifest *unstructured.Unstructured, namespaced func(runtime.Object) (bool, error)) error {
+	if g == nil {
+		return nil
+	}
+
+	gvk := manifest.GroupVersionKind()
+	if !g.AllowsKind(gvk) {
+		return NewPolicyViolation(ReasonKindNotAllowed, errKindNotAllowed, gvk)
+	}
+	if !g.AllowsNamespace(manifest.GetNamespace()) {
+		return NewPolicyViolation(ReasonNamespaceNotAllowed, errNamespaceNotAllowed, manifest.GetNamespace())
+	}
+	if g.ClusterScopedKinds != kconfig.ClusterScopedKindsDeny {
+		return nil
+	}
+	ok, err := namespaced(manifest)
+	if err != nil {
+		return errors.Wrap(err, errGetManifestScope)
+	}
+	if !ok {
+		return NewPolicyViolation(ReasonClusterScopedNotAllowed, errClusterScopedNotAllowed, gvk)
+	}
+	return nil
+}
+
+// CheckMaxObjects returns a PolicyViolation if the resource with the supplied
+// UID is beyond the maximum number of resources of the supplied guardrails
+// among the supplied usages of their provider config. Usages are ranked by
+// creation time, so that resources that use a provider config first are
+// within the limit. A resource without a usage ranks last.
+func CheckMaxObjects(g *kconfig.Guardrails, uid types.UID, usages []resource.ProviderConfigUsage) error {
+	if g == nil || g.MaxObjects == nil {
+		return nil
+	}
+
+	slices.SortFunc(usages, func(a, b resource.ProviderConfigUsage) int {
+		if c := a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GetNamespace()+"/"+a.GetName(), b.GetNamespace()+"/"+b.GetName())
+	})
+	rank := slices.IndexFunc(usages, func(u resource.ProviderConfigUsage) bool {
+		return u.GetResourceReference().UID == uid
+	})
+	if rank < 0 {
+		rank = len(usages)
+	}
+	if int64(rank) >= *g.MaxObjects {
+		return NewPolicyViolation(ReasonMaxObjectsExceeded, errMaxObjectsExceeded, *g.MaxObjects)
+	}
+	return nil
+}
+
+// CheckValidationRules returns a PolicyViolation naming the first of the
+// supplied rules that applies to, and is not satisfied by, the supplied
+// manifest. A rule that cannot be compiled or evaluated is a violation too,
+// since retrying would not make it pass.
+func CheckValidationRules(rules []kconfig.ValidationRule, manifest *unstructured.Unstructured) error {
+	gvk := manifest.GroupVersionKind()
+	for _, r := range rules {
+		if !r.AppliesTo(gvk) {
+			continue
+		}
+		p, err := compileValidationRule(r.Rule)
+		if err != nil {
+			return NewPolicyViolation(ReasonValidationRuleInvalid, errValidationRuleInvalid, r.Name, err)
+		}
+		ok, err := p.Eval(manifest.Object)
+		if err != nil {
+			return NewPolicyViolation(ReasonValidationRuleFailed, errValidationRuleEval, r.Name, err)
+		}
+		if !ok {
+			msg := r.Message
+			if msg == "" {
+				msg = r.Rule
+			}
+			return NewPolicyViolation(ReasonValidationRuleFailed, errValidationRuleFailed, r.Name, msg)
+		}
+	}
+	return nil
+}
+
+// compileValidationRule compiles the supplied validation rule, or returns the
+// result of compiling it earlier.
+func compileValidationRule(rule string) (*CELPredicate, error) {
+	if c, ok := compiledValidationRules.Get(rule); ok {
+		r := c.(compiledValidationRule)
+		return r.predicate, r.err
+	}
+	p, err := CompileCELPredicate(rule)
+	compiledValidationRules.Add(rule, compiledValidationRule{predicate: p, err: err})
+	return p, err
+}
+
+// A PolicyViolationRecorder is a provider config that records the policy
+// violations of the resources using it in its status.
+type PolicyViolationRecorder interface {
+	client.Object
+	GetPolicyViolations() []kconfig.PolicyViolation
+	SetPolicyViolations(v []kconfig.PolicyViolation)
+	GetPolicyViolationsTruncated() bool
+	SetPolicyViolationsTruncated(t bool)
+}
+
+// RecordPolicyViolation records the supplied violation in the status of the
+// supplied provider config, replacing any earlier violation of the same
+// resource. A nil violation removes any earlier violation of the resource.
+// The status is only patched if the recorded violations change, and the
+// provider config is read again if it was changed concurrently.
+func RecordPolicyViolation(ctx context.Context, kube client.Client, pc PolicyViolationRecorder, ref kconfig.PolicyViolation, v *PolicyViolation) error {
+	stale := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if stale {
+			if err := kube.Get(ctx, types.NamespacedName{Namespace: pc.GetNamespace(), Name: pc.GetName()}, pc); err != nil {
+				return err
+			}
+		}
+		stale = true
+		orig := pc.DeepCopyObject().(client.Object)
+		if !recordPolicyViolation(pc, ref, v) {
+			return nil
+		}
+		return kube.Status().Patch(ctx, pc, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{}))
+	})
+	return errors.Wrap(err, errUpdatePolicyViolations)
+}
+
+// recordPolicyViolation records the supplied violation of the referenced
+// resource in the supplied provider config, and returns true if this changed
+// its status. Violations beyond maxRecordedPolicyViolations are not listed,
+// but flagged as truncated.
+func recordPolicyViolation(pc PolicyViolationRecorder, ref kconfig.PolicyViolation, v *PolicyViolation) bool {
+	recorded := pc.GetPolicyViolations()
+	i := slices.IndexFunc(recorded, func(r kconfig.PolicyViolation) bool {
+		return r.Kind == ref.Kind && r.Namespace == ref.Namespace && r.Name == ref.Name
+	})
+
+	if v == nil {
+		if i < 0 {
+			return false
+		}
+		pc.SetPolicyViolations(slices.Delete(slices.Clone(recorded), i, i+1))
+		// Violations that were not listed are listed again when their
+		// resources are reconciled next.
+		pc.SetPolicyViolationsTruncated(false)
+		return true
+	}
+
+	ref.Reason = string(v.Reason)
+	ref.Message = v.Message
+	switch {
+	case i >= 0 && recorded[i] == ref:
+		return false
+	case i >= 0:
+		violations := slices.Clone(recorded)
+		violations[i] = ref
+		pc.SetPolicyViolations(violations)
+	case len(recorded) >= maxRecordedPolicyViolations:
+		if pc.GetPolicyViolationsTruncated() {
+			return false
+		}
+		pc.SetPolicyViolationsTruncated(true)
+	default:
+		pc.SetPolicyViolations(append(slices.Clone(recorded), ref))
+	}
+	return true
+}
+
+// CheckClusterProviderConfigAllowed returns a PolicyViolation if resources in
+// the supplied namespace may not use the supplied ClusterProviderConfig. The
+// namespace is only read if the allowed namespaces are selected by label.
+// Namespaces are not cached by the provider, so the supplied reader should
+// read from the API server, e.g. the manager's APIReader.
+func CheckClusterProviderConfigAllowed(ctx context.Context, kube client.Reader, cpc *apisnamespacedv1alpha1.ClusterProviderConfig, namespace string) error {
+	a := cpc.Spec.AllowedNamespaces
+	if a == nil {
+		return nil
+	}
+
+	var l labels.Set
+	if a.Selector != nil && !slices.Contains(a.Names, namespace) {
+		ns := &corev1.Namespace{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+			if kerrors.IsForbidden(err) {
+				return errors.Wrapf(err, errGetNamespaceForbidden, cpc.GetName())
+			}
+			return errors.Wrap(err, errGetNamespace)
+		}
+		l = ns.GetLabels()
+	}
+	ok, err := a.Allows(namespace, l)
+	if err != nil {
+		return errors.Wrap(err, errAllowedNamespaces)
+	}
+	if !ok {
+		return NewPolicyViolation(ReasonProviderConfigNotAllowed, errProviderConfigNotAllowed, cpc.GetName(), namespace)
+	}
+	return nil
+}
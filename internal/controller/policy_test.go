@@ -0,0 +1,463 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	apisv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/cluster/v1alpha1"
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+func TestCheckGuardrails(t *testing.T) {
+	errBoom := errors.New("boom")
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "foo", "namespace": "bar"},
+	}}
+	namespaced := func(namespaced bool, err error) func(runtime.Object) (bool, error) {
+		return func(runtime.Object) (bool, error) { return namespaced, err }
+	}
+
+	type args struct {
+		guardrails *kconfig.Guardrails
+		namespaced func(runtime.Object) (bool, error)
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoGuardrails": {
+			reason: "Any manifest should be allowed without guardrails.",
+		},
+		"KindAllowed": {
+			reason: "A manifest of an allowed kind should be allowed.",
+			args: args{
+				guardrails: &kconfig.Guardrails{AllowedKinds: []kconfig.KindSelector{{Kind: "ConfigMap"}}},
+			},
+		},
+		"KindNotAllowed": {
+			reason: "A manifest of a kind that is not allowed should be rejected.",
+			args: args{
+				guardrails: &kconfig.Guardrails{AllowedKinds: []kconfig.KindSelector{{Group: "apps", Kind: "*"}}},
+			},
+			want: NewPolicyViolation(ReasonKindNotAllowed, errKindNotAllowed, configMap.GroupVersionKind()),
+		},
+		"KindDenied": {
+			reason: "Denied kinds should take precedence over allowed kinds.",
+			args: args{
+				guardrails: &kconfig.Guardrails{
+					AllowedKinds: []kconfig.KindSelector{{Group: "*", Kind: "*"}},
+					DeniedKinds:  []kconfig.KindSelector{{Version: "v1", Kind: "ConfigMap"}},
+				},
+			},
+			want: NewPolicyViolation(ReasonKindNotAllowed, errKindNotAllowed, configMap.GroupVersionKind()),
+		},
+		"NamespaceNotAllowed": {
+			reason: "A manifest in a namespace that is not allowed should be rejected.",
+			args: args{
+				guardrails: &kconfig.Guardrails{AllowedNamespaces: []string{"baz"}},
+			},
+			want: NewPolicyViolation(ReasonNamespaceNotAllowed, errNamespaceNotAllowed, "bar"),
+		},
+		"NamespaceScoped": {
+			reason: "A namespace-scoped manifest should be allowed if cluster-scoped kinds are denied.",
+			args: args{
+				guardrails: &kconfig.Guardrails{ClusterScopedKinds: kconfig.ClusterScopedKindsDeny},
+				namespaced: namespaced(true, nil),
+			},
+		},
+		"ClusterScopedNotAllowed": {
+			reason: "A cluster-scoped manifest should be rejected if cluster-scoped kinds are denied.",
+			args: args{
+				guardrails: &kconfig.Guardrails{ClusterScopedKinds: kconfig.ClusterScopedKindsDeny},
+				namespaced: namespaced(false, nil),
+			},
+			want: NewPolicyViolation(ReasonClusterScopedNotAllowed, errClusterScopedNotAllowed, configMap.GroupVersionKind()),
+		},
+		"ScopeUnknown": {
+			reason: "An error determining the scope of the manifest should be returned.",
+			args: args{
+				guardrails: &kconfig.Guardrails{ClusterScopedKinds: kconfig.ClusterScopedKindsDeny},
+				namespaced: namespaced(false, errBoom),
+			},
+			want: errors.Wrap(errBoom, errGetManifestScope),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckGuardrails(tc.args.guardrails, configMap, tc.args.namespaced)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckGuardrails(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckValidationRules(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "foo", "namespace": "bar"},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"template": map[string]any{"spec": map[string]any{
+				"containers": []any{
+					map[string]any{"name": "app", "image": "registry.example.org/app:v1"},
+				},
+			}},
+		},
+	}}
+	registry := kconfig.ValidationRule{
+		Name: "trusted-registry",
+		Rule: "object.spec.template.spec.containers.all(c, c.image.startsWith('registry.example.org/'))",
+	}
+
+	cases := map[string]struct {
+		reason string
+		rules  []kconfig.ValidationRule
+		want   error
+	}{
+		"NoRules": {
+			reason: "Any manifest should be valid without validation rules.",
+		},
+		"Satisfied": {
+			reason: "A manifest that satisfies all rules should be valid.",
+			rules: []kconfig.ValidationRule{
+				registry,
+				{Name: "replicas", Rule: "object.spec.replicas <= 5"},
+			},
+		},
+		"Violated": {
+			reason: "A manifest that does not satisfy a rule should violate it.",
+			rules: []kconfig.ValidationRule{
+				registry,
+				{Name: "replicas", Rule: "object.spec.replicas <= 2", Message: "at most 2 replicas"},
+			},
+			want: NewPolicyViolation(ReasonValidationRuleFailed, errValidationRuleFailed, "replicas", "at most 2 replicas"),
+		},
+		"ViolatedWithoutMessage": {
+			reason: "The rule itself should be reported if it has no message.",
+			rules:  []kconfig.ValidationRule{{Name: "replicas", Rule: "object.spec.replicas <= 2"}},
+			want:   NewPolicyViolation(ReasonValidationRuleFailed, errValidationRuleFailed, "replicas", "object.spec.replicas <= 2"),
+		},
+		"OtherKind": {
+			reason: "A rule should not apply to kinds it does not select.",
+			rules: []kconfig.ValidationRule{{
+				Name:  "no-configmaps",
+				Rule:  "false",
+				Kinds: []kconfig.KindSelector{{Kind: "ConfigMap"}},
+			}},
+		},
+		"NotBool": {
+			reason: "A rule that does not evaluate to a bool should be invalid.",
+			rules:  []kconfig.ValidationRule{{Name: "name", Rule: "'foo'"}},
+			want:   NewPolicyViolation(ReasonValidationRuleInvalid, errValidationRuleInvalid, "name", errCELNotBool),
+		},
+		"CannotEvaluate": {
+			reason: "A rule that cannot be evaluated against the manifest should be violated.",
+			rules:  []kconfig.ValidationRule{{Name: "host-network", Rule: "!object.spec.template.spec.hostNetwork"}},
+			want:   NewPolicyViolation(ReasonValidationRuleFailed, errValidationRuleEval, "host-network", "no such key: hostNetwork"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckValidationRules(tc.rules, deployment)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckValidationRules(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCompileValidationRule(t *testing.T) {
+	rule := "object.metadata.name == 'cached'"
+	first, err := compileValidationRule(rule)
+	if err != nil {
+		t.Fatalf("compileValidationRule(...): unexpected error: %v", err)
+	}
+	second, err := compileValidationRule(rule)
+	if err != nil {
+		t.Fatalf("compileValidationRule(...): unexpected error: %v", err)
+	}
+	if first != second {
+		t.Errorf("compileValidationRule(...): want the rule compiled earlier to be reused")
+	}
+
+	if _, err := compileValidationRule("object.metadata.name =="); err == nil {
+		t.Errorf("compileValidationRule(...): want error compiling an invalid rule")
+	}
+	if _, err := compileValidationRule("object.metadata.name =="); err == nil {
+		t.Errorf("compileValidationRule(...): want cached error compiling an invalid rule")
+	}
+}
+
+func TestCheckMaxObjects(t *testing.T) {
+	now := time.Now()
+	usage := func(name string, uid types.UID, created time.Time) resource.ProviderConfigUsage {
+		u := &apisv1alpha1.ProviderConfigUsage{}
+		u.SetName(name)
+		u.SetCreationTimestamp(metav1.NewTime(created))
+		u.ResourceReference = xpv2.TypedReference{UID: uid}
+		return u
+	}
+	usages := func() []resource.ProviderConfigUsage {
+		return []resource.ProviderConfigUsage{
+			usage("c", "third", now.Add(time.Minute)),
+			usage("a", "first", now),
+			usage("b", "second", now),
+		}
+	}
+
+	type args struct {
+		max *int64
+		uid types.UID
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoLimit": {
+			reason: "Any number of resources should be allowed without a limit.",
+			args:   args{uid: "third"},
+		},
+		"WithinLimit": {
+			reason: "Resources that used the provider config first should be within the limit.",
+			args:   args{max: ptr.To[int64](2), uid: "second"},
+		},
+		"BeyondLimit": {
+			reason: "Resources that used the provider config last should be beyond the limit.",
+			args:   args{max: ptr.To[int64](2), uid: "third"},
+			want:   NewPolicyViolation(ReasonMaxObjectsExceeded, errMaxObjectsExceeded, 2),
+		},
+		"NoUsage": {
+			reason: "A resource without a usage should rank last.",
+			args:   args{max: ptr.To[int64](3), uid: "fourth"},
+			want:   NewPolicyViolation(ReasonMaxObjectsExceeded, errMaxObjectsExceeded, 3),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckMaxObjects(&kconfig.Guardrails{MaxObjects: tc.args.max}, tc.args.uid, usages())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckMaxObjects(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckClusterProviderConfigAllowed(t *testing.T) {
+	errBoom := errors.New("boom")
+	errForbidden := kerrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "team-a", errBoom)
+	allowed := &apisnamespacedv1alpha1.AllowedNamespaces{
+		Names:    []string{"platform"},
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "platform"}},
+	}
+
+	type args struct {
+		allowed   *apisnamespacedv1alpha1.AllowedNamespaces
+		namespace string
+		labels    map[string]string
+		err       error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Unrestricted": {
+			reason: "Resources in any namespace should be allowed without allowed namespaces.",
+			args:   args{namespace: "team-a", err: errBoom},
+		},
+		"AllowedByName": {
+			reason: "The namespace should not be read if it is allowed by name.",
+			args:   args{allowed: allowed, namespace: "platform", err: errBoom},
+		},
+		"AllowedByLabel": {
+			reason: "A namespace with matching labels should be allowed.",
+			args:   args{allowed: allowed, namespace: "team-a", labels: map[string]string{"team": "platform"}},
+		},
+		"NotAllowed": {
+			reason: "A namespace that is neither named nor selected should be rejected.",
+			args:   args{allowed: allowed, namespace: "team-a"},
+			want:   NewPolicyViolation(ReasonProviderConfigNotAllowed, errProviderConfigNotAllowed, "pc", "team-a"),
+		},
+		"GetNamespaceForbidden": {
+			reason: "A missing permission to read namespaces should be reported as such.",
+			args:   args{allowed: allowed, namespace: "team-a", err: errForbidden},
+			want:   errors.Wrapf(errForbidden, errGetNamespaceForbidden, "pc"),
+		},
+		"GetNamespaceError": {
+			reason: "Errors reading the namespace should be returned.",
+			args:   args{allowed: allowed, namespace: "team-a", err: errBoom},
+			want:   errors.Wrap(errBoom, errGetNamespace),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cpc := &apisnamespacedv1alpha1.ClusterProviderConfig{}
+			cpc.SetName("pc")
+			cpc.Spec.AllowedNamespaces = tc.args.allowed
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.SetLabels(tc.args.labels)
+					return tc.args.err
+				},
+			}
+			err := CheckClusterProviderConfigAllowed(context.Background(), kube, cpc, tc.args.namespace)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckClusterProviderConfigAllowed(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecordPolicyViolation(t *testing.T) {
+	ref := kconfig.PolicyViolation{Kind: "Object", Name: "foo"}
+	violation := NewPolicyViolation(ReasonKindNotAllowed, "denied")
+	recorded := kconfig.PolicyViolation{Kind: "Object", Name: "foo", Reason: string(ReasonKindNotAllowed), Message: "denied"}
+	other := kconfig.PolicyViolation{Kind: "Object", Name: "bar", Reason: string(ReasonMaxObjectsExceeded)}
+
+	full := make([]kconfig.PolicyViolation, maxRecordedPolicyViolations)
+	for i := range full {
+		full[i] = kconfig.PolicyViolation{Kind: "Object", Name: fmt.Sprintf("other-%d", i)}
+	}
+
+	type args struct {
+		recorded  []kconfig.PolicyViolation
+		truncated bool
+		violation *PolicyViolation
+		conflicts int
+	}
+	type want struct {
+		recorded  []kconfig.PolicyViolation
+		truncated bool
+		updated   bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Record": {
+			reason: "A new violation should be recorded.",
+			args: args{
+				recorded:  []kconfig.PolicyViolation{other},
+				violation: violation,
+			},
+			want: want{recorded: []kconfig.PolicyViolation{other, recorded}, updated: true},
+		},
+		"AlreadyRecorded": {
+			reason: "A violation that is already recorded should not update the provider config.",
+			args: args{
+				recorded:  []kconfig.PolicyViolation{recorded},
+				violation: violation,
+			},
+			want: want{recorded: []kconfig.PolicyViolation{recorded}},
+		},
+		"Remove": {
+			reason: "A recorded violation should be removed once it is resolved.",
+			args: args{
+				recorded: []kconfig.PolicyViolation{recorded, other},
+			},
+			want: want{recorded: []kconfig.PolicyViolation{other}, updated: true},
+		},
+		"Truncate": {
+			reason: "A violation beyond the maximum number of listed violations should only be flagged.",
+			args: args{
+				recorded:  full,
+				violation: violation,
+			},
+			want: want{recorded: full, truncated: true, updated: true},
+		},
+		"AlreadyTruncated": {
+			reason: "The provider config should not be updated if violations are already flagged as truncated.",
+			args: args{
+				recorded:  full,
+				truncated: true,
+				violation: violation,
+			},
+			want: want{recorded: full, truncated: true},
+		},
+		"RemoveTruncated": {
+			reason: "Removing a violation should make room for violations that were not listed.",
+			args: args{
+				recorded:  []kconfig.PolicyViolation{recorded, other},
+				truncated: true,
+			},
+			want: want{recorded: []kconfig.PolicyViolation{other}, updated: true},
+		},
+		"Conflict": {
+			reason: "The provider config should be read again and patched if it was changed concurrently.",
+			args: args{
+				recorded:  []kconfig.PolicyViolation{other},
+				violation: violation,
+				conflicts: 1,
+			},
+			want: want{recorded: []kconfig.PolicyViolation{other, recorded}, updated: true},
+		},
+		"NothingToRemove": {
+			reason: "The provider config should not be updated if there is no violation to remove.",
+			args: args{
+				recorded: []kconfig.PolicyViolation{other},
+			},
+			want: want{recorded: []kconfig.PolicyViolation{other}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stored := &apisv1alpha1.ProviderConfig{}
+			stored.SetPolicyViolations(tc.args.recorded)
+			stored.SetPolicyViolationsTruncated(tc.args.truncated)
+			pc := stored.DeepCopy()
+			conflicts := tc.args.conflicts
+			updated := false
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					stored.DeepCopyInto(obj.(*apisv1alpha1.ProviderConfig))
+					return nil
+				},
+				MockStatusPatch: func(_ context.Context, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					if conflicts > 0 {
+						conflicts--
+						return kerrors.NewConflict(schema.GroupResource{}, "pc", errors.New("changed"))
+					}
+					updated = true
+					return nil
+				},
+			}
+			if err := RecordPolicyViolation(context.Background(), kube, pc, ref, tc.args.violation); err != nil {
+				t.Fatalf("\n%s\nRecordPolicyViolation(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.recorded, pc.GetPolicyViolations()); diff != "" {
+				t.Errorf("\n%s\nRecordPolicyViolation(...): -want violations, +got violations:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.truncated, pc.GetPolicyViolationsTruncated()); diff != "" {
+				t.Errorf("\n%s\nRecordPolicyViolation(...): -want truncated, +got truncated:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nRecordPolicyViolation(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+
+	apisnamespacedv1alpha1 "github.com/crossplane-contrib/provider-kubernetes/apis/namespaced/v1alpha1"
+	kconfig "github.com/crossplane-contrib/provider-kubernetes/pkg/kube/config"
+)
+
+// clusterScopedKinds are the well-known cluster-scoped kinds of the built-in
+// Kubernetes APIs. The scope of arbitrary kinds can only be discovered
+// against the target cluster, which is not possible at admission time, so
+// only these are checked statically.
+var clusterScopedKinds = sets.New(
+	schema.GroupKind{Kind: "Namespace"},
+	schema.GroupKind{Kind: "Node"},
+	schema.GroupKind{Kind: "PersistentVolume"},
+	schema.GroupKind{Kind: "ComponentStatus"},
+	schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+	schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"},
+	schema.GroupKind{Group: "apiregistration.k8s.io", Kind: "APIService"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"},
+	schema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicyBinding"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "StorageClass"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "CSIDriver"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "CSINode"},
+	schema.GroupKind{Group: "storage.k8s.io", Kind: "VolumeAttachment"},
+	schema.GroupKind{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+	schema.GroupKind{Group: "node.k8s.io", Kind: "RuntimeClass"},
+	schema.GroupKind{Group: "networking.k8s.io", Kind: "IngressClass"},
+	schema.GroupKind{Group: "certificates.k8s.io", Kind: "CertificateSigningRequest"},
+	schema.GroupKind{Group: "flowcontrol.apiserver.k8s.io", Kind: "FlowSchema"},
+	schema.GroupKind{Group: "flowcontrol.apiserver.k8s.io", Kind: "PriorityLevelConfiguration"},
+)
+
+// IsClusterScopedKind returns true if the supplied kind is a well-known
+// cluster-scoped kind of the built-in Kubernetes APIs.
+func IsClusterScopedKind(gk schema.GroupKind) bool {
+	return clusterScopedKinds.Has(gk)
+}
+
+// ValidateManifest runs structural checks on an already decoded object
+// manifest, reporting the offending fields relative to fldPath.
+func ValidateManifest(m *unstructured.Unstructured, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	gv, err := schema.ParseGroupVersion(m.GetAPIVersion())
+	switch {
+	case m.GetAPIVersion() == "":
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), "apiVersion must be set"))
+	case err != nil:
+		errs = append(errs, field.Invalid(fldPath.Child("apiVersion"), m.GetAPIVersion(), err.Error()))
+	}
+	if m.GetKind() == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), "kind must be set"))
+	}
+
+	return append(errs, ValidateNamespace(gv.WithKind(m.GetKind()).GroupKind(), m.GetNamespace(), fldPath.Child("metadata", "namespace"))...)
+}
+
+// ValidateNamespace checks that the supplied namespace is a valid namespace
+// name and is not set for a well-known cluster-scoped kind.
+func ValidateNamespace(gk schema.GroupKind, namespace string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if namespace == "" {
+		return errs
+	}
+	for _, msg := range validation.IsDNS1123Label(namespace) {
+		errs = append(errs, field.Invalid(fldPath, namespace, msg))
+	}
+	if IsClusterScopedKind(gk) {
+		errs = append(errs, field.Forbidden(fldPath, fmt.Sprintf("%s is cluster-scoped and must not set a namespace", gk.String())))
+	}
+	return errs
+}
+
+// ValidateFieldPath checks that the supplied path is a valid field path.
+func ValidateFieldPath(path string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if path == "" {
+		return append(errs, field.Required(fldPath, "field path must not be empty"))
+	}
+	if _, err := fieldpath.Parse(path); err != nil {
+		errs = append(errs, field.Invalid(fldPath, path, err.Error()))
+	}
+	return errs
+}
+
+// ValidateValidationRules checks that the supplied validation rules of a
+// provider config compile to CEL predicates, whose estimated worst case cost
+// is within the limit.
+func ValidateValidationRules(rules []kconfig.ValidationRule, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, r := range rules {
+		if _, err := CompileCELPredicate(r.Rule); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Index(i).Child("rule"), r.Rule, err.Error()))
+			continue
+		}
+		if err := checkCELCost(r.Rule); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Index(i).Child("rule"), r.Rule, err.Error()))
+		}
+	}
+	return errs
+}
+
+// ValidateProviderConfigReference checks that the supplied reference does not
+// refer to a ClusterProviderConfig that may not be used in the supplied
+// namespace. A provider config that cannot be read is left to the controller
+// to report. The namespace is read with the supplied namespaces reader if the
+// ClusterProviderConfig selects its allowed namespaces by label.
+func ValidateProviderConfigReference(ctx context.Context, kube, namespaces client.Reader, ref *xpv2.ProviderConfigReference, namespace string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if kube == nil || ref == nil || ref.Kind != apisnamespacedv1alpha1.ClusterProviderConfigKind {
+		return errs
+	}
+	cpc := &apisnamespacedv1alpha1.ClusterProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name}, cpc); err != nil {
+		return errs
+	}
+	if v, ok := AsPolicyViolation(CheckClusterProviderConfigAllowed(ctx, namespaces, cpc, namespace)); ok {
+		errs = append(errs, field.Forbidden(fldPath, v.Message))
+	}
+	return errs
+}
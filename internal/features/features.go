@@ -25,4 +25,8 @@ const (
 	EnableAlphaWatches feature.Flag = "EnableAlphaWatches"
 	// EnableBetaServerSideApply enables beta support for Server Side Apply.
 	EnableBetaServerSideApply feature.Flag = "EnableBetaServerSideApply"
+	// EnableAlphaManifestValidation enables alpha support for validating
+	// Object manifests against the OpenAPI schemas of the target cluster
+	// before applying them. It requires server-side apply.
+	EnableAlphaManifestValidation feature.Flag = "EnableAlphaManifestValidation"
 )
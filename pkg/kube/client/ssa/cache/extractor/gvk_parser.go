@@ -48,6 +48,10 @@ const groupVersionKindExtensionKey = "x-kubernetes-group-version-kind"
 type GvkParser struct {
 	gvks   map[schema.GroupVersionKind]string
 	parser typed.Parser
+	// schemas are the OpenAPI schemas the parser was built from, keyed by
+	// component name. They are kept to check constraints, such as required
+	// fields, that are not part of the structured merge diff schema.
+	schemas map[string]*spec.Schema
 }
 
 // Type returns a helper which can produce objects of the given type. Any
@@ -72,7 +76,8 @@ func NewGVKParser(componentNameToSchema map[string]*spec.Schema, preserveUnknown
 		return nil, errors.Wrap(err, "failed to convert models to schema")
 	}
 	parser := GvkParser{
-		gvks: map[schema.GroupVersionKind]string{},
+		gvks:    map[schema.GroupVersionKind]string{},
+		schemas: componentNameToSchema,
 	}
 	parser.parser = typed.Parser{Schema: smdschema.Schema{Types: typeSchema.Types}}
 	for modelName, ss := range componentNameToSchema {
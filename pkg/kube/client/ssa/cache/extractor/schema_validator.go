@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extractor
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/structured-merge-diff/v6/typed"
+)
+
+const (
+	errNoSchemaForKind = "cannot find OpenAPI schema for %s"
+	errValidateObject  = "cannot validate object against its OpenAPI schema"
+
+	msgNotDeclared = "field not declared in schema"
+
+	// maxRefDepth bounds the number of references followed while resolving
+	// a single schema, guarding against self-referencing schemas.
+	maxRefDepth = 32
+)
+
+// A SchemaValidator validates objects against the OpenAPI schema of their
+// GroupVersionKind, as served by the target cluster.
+type SchemaValidator interface {
+	// Validate returns the schema violations of the supplied object. Missing
+	// required fields are only reported if create is true, since server-side
+	// apply validates the object merged with the existing one otherwise. An
+	// error is returned only if the object could not be validated at all,
+	// e.g. because its schema could not be retrieved.
+	Validate(object *unstructured.Unstructured, create bool) (field.ErrorList, error)
+}
+
+// NewCachingSchemaValidator returns a SchemaValidator backed by the same
+// per-GroupVersion parser cache as the unstructured extractor, so schemas are
+// downloaded and parsed only once per ProviderConfig.
+func NewCachingSchemaValidator(ctx context.Context, dc discovery.DiscoveryInterface, cache *GVKParserCache) SchemaValidator {
+	return &cachingUnstructuredExtractor{
+		dc:    dc,
+		cache: cache,
+		ctx:   ctx,
+	}
+}
+
+// Validate validates the supplied object against the cached schema of its
+// GroupVersion.
+func (e *cachingUnstructuredExtractor) Validate(object *unstructured.Unstructured, create bool) (field.ErrorList, error) {
+	parser, err := e.getParserForGV(e.ctx, object.GroupVersionKind().GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+	return parser.Validate(object, create)
+}
+
+// Validate returns the fields of the supplied object that are not declared
+// in its schema, i.e. that the API server would prune, and fields whose value
+// does not match the declared type. If create is true, required fields that
+// are missing and have no default are reported, too.
+func (p *GvkParser) Validate(object *unstructured.Unstructured, create bool) (field.ErrorList, error) {
+	gvk := object.GroupVersionKind()
+	t := p.Type(gvk)
+	if t == nil {
+		return nil, errors.Errorf(errNoSchemaForKind, gvk)
+	}
+
+	errs := field.ErrorList{}
+	if _, err := t.FromUnstructured(object.Object); err != nil {
+		var verrs typed.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return nil, errors.Wrap(err, errValidateObject)
+		}
+		for _, ve := range verrs {
+			errs = append(errs, fromValidationError(ve))
+		}
+	}
+	if !create {
+		return errs, nil
+	}
+	return append(errs, p.missingRequired(p.schemas[p.gvks[gvk]], object.Object, nil)...), nil
+}
+
+func fromValidationError(ve typed.ValidationError) *field.Error {
+	path := field.NewPath(strings.TrimPrefix(ve.Path, "."))
+	if ve.ErrorMessage == msgNotDeclared {
+		return field.Forbidden(path, "field not declared in schema and would be pruned")
+	}
+	return field.TypeInvalid(path, field.OmitValueType{}, ve.ErrorMessage)
+}
+
+// missingRequired walks the supplied value alongside its schema and reports
+// the required properties that are not set. Properties with a default are
+// skipped, as the API server fills them in before validating.
+func (p *GvkParser) missingRequired(s *spec.Schema, v any, path *field.Path) field.ErrorList {
+	s = p.resolve(s)
+	if s == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+	switch v := v.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; ok || p.hasDefault(s.Properties[name]) {
+				continue
+			}
+			errs = append(errs, field.Required(path.Child(name), "required by schema"))
+		}
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			if ps, ok := s.Properties[k]; ok {
+				errs = append(errs, p.missingRequired(&ps, v[k], path.Child(k))...)
+				continue
+			}
+			if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+				errs = append(errs, p.missingRequired(s.AdditionalProperties.Schema, v[k], path.Key(k))...)
+			}
+		}
+	case []any:
+		if s.Items == nil || s.Items.Schema == nil {
+			return errs
+		}
+		for i, iv := range v {
+			errs = append(errs, p.missingRequired(s.Items.Schema, iv, path.Index(i))...)
+		}
+	}
+	return errs
+}
+
+// hasDefault returns true if the supplied property schema, or the schema it
+// refers to, declares a default.
+func (p *GvkParser) hasDefault(s spec.Schema) bool {
+	if s.Default != nil {
+		return true
+	}
+	r := p.resolve(&s)
+	return r != nil && r.Default != nil
+}
+
+// resolve follows local references and single-element allOf wrappers, which
+// is how OpenAPI v3 documents of Kubernetes refer to component schemas.
+func (p *GvkParser) resolve(s *spec.Schema) *spec.Schema {
+	for range maxRefDepth {
+		switch {
+		case s == nil:
+			return nil
+		case s.Ref.String() != "":
+			tokens := s.Ref.GetPointer().DecodedTokens()
+			if len(tokens) == 0 {
+				return nil
+			}
+			s = p.schemas[tokens[len(tokens)-1]]
+		case len(s.AllOf) == 1 && len(s.Properties) == 0:
+			s = &s.AllOf[0]
+		default:
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package extractor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestValidate(t *testing.T) {
+	deployment := func(spec string) []byte {
+		return []byte(`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "foo", "namespace": "default"}, "spec": ` + spec + `}`)
+	}
+	tests := map[string]struct {
+		reason string
+		object []byte
+		create bool
+		want   []string
+	}{
+		"Valid": {
+			reason: "An object that conforms to its schema should have no violations.",
+			object: deployment(`{"replicas": 2, "selector": {"matchLabels": {"app": "foo"}}, "template": {"metadata": {"labels": {"app": "foo"}}, "spec": {"containers": [{"name": "foo", "image": "nginx"}]}}}`),
+			create: true,
+		},
+		"UnknownField": {
+			reason: "A field that is not declared in the schema would be pruned and should be reported.",
+			object: deployment(`{"replica": 2, "selector": {"matchLabels": {"app": "foo"}}, "template": {}}`),
+			want:   []string{"spec.replica: Forbidden"},
+		},
+		"TypeMismatch": {
+			reason: "A value of the wrong type should be reported.",
+			object: deployment(`{"replicas": "two", "selector": {"matchLabels": {"app": "foo"}}, "template": {}}`),
+			want:   []string{"spec.replicas: Invalid value"},
+		},
+		"MissingRequired": {
+			reason: "Required fields should be reported when the object is created.",
+			object: deployment(`{"template": {}}`),
+			create: true,
+			want:   []string{"spec.selector: Required value"},
+		},
+		"MissingRequiredWithDefault": {
+			reason: "Required fields with a default should not be reported, since the API server fills them in.",
+			object: deployment(`{"selector": {"matchLabels": {"app": "foo"}}}`),
+			create: true,
+		},
+		"PartialManifestOfExistingObject": {
+			reason: "Required fields should not be reported unless the object is created, since server-side apply validates the merged object.",
+			object: deployment(`{"replicas": 2}`),
+		},
+	}
+
+	mockK8sAPIServer, err := newMockAPIServer()
+	if err != nil {
+		t.Fatalf("cannot initialize mock API server: %v", err)
+	}
+	rc := &rest.Config{
+		Host: mockK8sAPIServer.server.URL,
+		ContentConfig: rest.ContentConfig{
+			NegotiatedSerializer: scheme.Codecs,
+			GroupVersion:         &appsv1.SchemeGroupVersion,
+		},
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewCachingSchemaValidator(context.TODO(), dc, &GVKParserCache{
+		store: map[schema.GroupVersion]*gvkParserCacheEntry{},
+	})
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := &unstructured.Unstructured{}
+			if err := u.UnmarshalJSON(tc.object); err != nil {
+				t.Fatal(err)
+			}
+			errs, err := v.Validate(u, tc.create)
+			if err != nil {
+				t.Fatalf("Validate(...): unexpected error: %v", err)
+			}
+			got := make([]string, 0, len(errs))
+			for _, e := range errs {
+				got = append(got, e.Field+": "+e.Type.String())
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s\n%v", tc.reason, diff, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ssa contains helpers for server-side apply field managers.
+package ssa
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+const errMergeAPIVersions = "cannot transfer fields of field manager %q at %s to %q at %s"
+
+// FieldOwner returns the server-side apply field manager of the Object with
+// the supplied name.
+func FieldOwner(objectName string) string {
+	return fmt.Sprintf("provider-kubernetes/%s", objectName)
+}
+
+// TransferFieldOwnershipPatch returns a JSON patch that transfers the fields
+// applied by the from field manager to the to field manager, which becomes
+// the applier of those fields as if it had applied them itself. Like the
+// patches of k8s.io/client-go/util/csaupgrade, it replaces the managed fields
+// as a whole and includes the resourceVersion of the supplied object, so it
+// is rejected if the object has changed in the meantime. Nil is returned if
+// there is nothing to transfer.
+func TransferFieldOwnershipPatch(obj runtime.Object, from, to string) ([]byte, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	managedFields := accessor.GetManagedFields()
+	transferred, err := transferredManagedFields(managedFields, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(managedFields, transferred) {
+		return nil, nil
+	}
+
+	return json.Marshal([]map[string]any{
+		{
+			"op":    "replace",
+			"path":  "/metadata/managedFields",
+			"value": transferred,
+		},
+		{
+			"op":    "replace",
+			"path":  "/metadata/resourceVersion",
+			"value": accessor.GetResourceVersion(),
+		},
+	})
+}
+
+func transferredManagedFields(managedFields []metav1.ManagedFieldsEntry, from, to string) ([]metav1.ManagedFieldsEntry, error) {
+	isApplyOf := func(manager string) func(metav1.ManagedFieldsEntry) bool {
+		return func(e metav1.ManagedFieldsEntry) bool {
+			return e.Manager == manager && e.Operation == metav1.ManagedFieldsOperationApply && e.Subresource == ""
+		}
+	}
+	fromIndex := index(managedFields, isApplyOf(from))
+	if fromIndex < 0 || from == to {
+		return managedFields, nil
+	}
+
+	result := make([]metav1.ManagedFieldsEntry, 0, len(managedFields))
+	toIndex := index(managedFields, isApplyOf(to))
+	if toIndex < 0 {
+		// Nothing is applied by the new manager yet, so the entry of the old
+		// one is simply renamed.
+		for i, e := range managedFields {
+			if i == fromIndex {
+				e.Manager = to
+			}
+			result = append(result, e)
+		}
+		return result, nil
+	}
+
+	f, t := managedFields[fromIndex], managedFields[toIndex]
+	if f.APIVersion != t.APIVersion {
+		return nil, errors.Errorf(errMergeAPIVersions, from, f.APIVersion, to, t.APIVersion)
+	}
+	fs, err := fieldSet(f)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := fieldSet(t)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ts.Union(fs).ToJSON()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode field set")
+	}
+	for i, e := range managedFields {
+		switch i {
+		case fromIndex:
+			continue
+		case toIndex:
+			e.FieldsV1 = &metav1.FieldsV1{Raw: raw}
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+// MergedFieldsEntry returns an Apply entry of the supplied field manager that
+// owns all fields of the main resource managed by the supplied managers at the
+// supplied API version, whether they applied or updated them. All managers are
+// merged if none are supplied. Nil is returned if none of them manages any
+// field at that API version. As the API server does not record defaulted
+// values as managed, extracting the fields of this entry yields the fields
+// that were set by clients.
+func MergedFieldsEntry(managedFields []metav1.ManagedFieldsEntry, apiVersion string, managers []string, into string) (*metav1.ManagedFieldsEntry, error) {
+	var merged *fieldpath.Set
+	for _, e := range managedFields {
+		if e.Subresource != "" || e.APIVersion != apiVersion || (len(managers) > 0 && !slices.Contains(managers, e.Manager)) {
+			continue
+		}
+		fs, err := fieldSet(e)
+		if err != nil {
+			return nil, err
+		}
+		if merged == nil {
+			merged = fs
+			continue
+		}
+		merged = merged.Union(fs)
+	}
+	if merged == nil {
+		return nil, nil
+	}
+	raw, err := merged.ToJSON()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode field set")
+	}
+	return &metav1.ManagedFieldsEntry{
+		Manager:    into,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: apiVersion,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: raw},
+	}, nil
+}
+
+func fieldSet(e metav1.ManagedFieldsEntry) (*fieldpath.Set, error) {
+	s := &fieldpath.Set{}
+	if e.FieldsV1 == nil {
+		return s, nil
+	}
+	return s, errors.Wrap(s.FromJSON(bytes.NewReader(e.FieldsV1.Raw)), "failed to convert fields to set")
+}
+
+func index(entries []metav1.ManagedFieldsEntry, match func(metav1.ManagedFieldsEntry) bool) int {
+	for i, e := range entries {
+		if match(e) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,139 @@
+package ssa
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTransferredManagedFields(t *testing.T) {
+	entry := func(manager string, op metav1.ManagedFieldsOperationType, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  op,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+		}
+	}
+	other := entry("kubectl", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:other":{}}}`)
+
+	type want struct {
+		fields []metav1.ManagedFieldsEntry
+		err    bool
+	}
+	cases := map[string]struct {
+		reason string
+		fields []metav1.ManagedFieldsEntry
+		want   want
+	}{
+		"NothingToTransfer": {
+			reason: "Managed fields without the old manager should not change.",
+			fields: []metav1.ManagedFieldsEntry{other},
+			want:   want{fields: []metav1.ManagedFieldsEntry{other}},
+		},
+		"Rename": {
+			reason: "The entry of the old manager should be renamed if the new one has none.",
+			fields: []metav1.ManagedFieldsEntry{other, entry("old", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`)},
+			want: want{fields: []metav1.ManagedFieldsEntry{
+				other,
+				entry("new", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+			}},
+		},
+		"Merge": {
+			reason: "The fields of the old manager should be merged into the entry of the new one.",
+			fields: []metav1.ManagedFieldsEntry{
+				entry("new", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:bar":{}}}`),
+				entry("old", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+				other,
+			},
+			want: want{fields: []metav1.ManagedFieldsEntry{
+				entry("new", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:bar":{},"f:foo":{}}}`),
+				other,
+			}},
+		},
+		"UpdateOperationsUntouched": {
+			reason: "Only fields applied by the old manager should be transferred.",
+			fields: []metav1.ManagedFieldsEntry{entry("old", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:foo":{}}}`)},
+			want:   want{fields: []metav1.ManagedFieldsEntry{entry("old", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:foo":{}}}`)}},
+		},
+		"DifferentAPIVersions": {
+			reason: "Entries of different API versions cannot be merged.",
+			fields: []metav1.ManagedFieldsEntry{
+				func() metav1.ManagedFieldsEntry {
+					e := entry("new", metav1.ManagedFieldsOperationApply, `{}`)
+					e.APIVersion = "v2"
+					return e
+				}(),
+				entry("old", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+			},
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := transferredManagedFields(tc.fields, "old", "new")
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\ntransferredManagedFields(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.fields, got); diff != "" {
+				t.Errorf("\n%s\ntransferredManagedFields(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMergedFieldsEntry(t *testing.T) {
+	entry := func(manager string, op metav1.ManagedFieldsOperationType, apiVersion, subresource, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:     manager,
+			Operation:   op,
+			APIVersion:  apiVersion,
+			Subresource: subresource,
+			FieldsType:  "FieldsV1",
+			FieldsV1:    &metav1.FieldsV1{Raw: []byte(fields)},
+		}
+	}
+	fields := []metav1.ManagedFieldsEntry{
+		entry("kubectl", metav1.ManagedFieldsOperationUpdate, "apps/v1", "", `{"f:spec":{"f:replicas":{}}}`),
+		entry("helm", metav1.ManagedFieldsOperationApply, "apps/v1", "", `{"f:spec":{"f:template":{}}}`),
+		entry("old", metav1.ManagedFieldsOperationUpdate, "apps/v1beta1", "", `{"f:spec":{"f:paused":{}}}`),
+		entry("controller", metav1.ManagedFieldsOperationUpdate, "apps/v1", "status", `{"f:status":{}}`),
+	}
+	merged := func(f string) *metav1.ManagedFieldsEntry {
+		e := entry("import", metav1.ManagedFieldsOperationApply, "apps/v1", "", f)
+		return &e
+	}
+
+	cases := map[string]struct {
+		reason   string
+		managers []string
+		want     *metav1.ManagedFieldsEntry
+	}{
+		"AllManagers": {
+			reason: "Fields of all managers of the main resource at the API version should be merged.",
+			want:   merged(`{"f:spec":{"f:replicas":{},"f:template":{}}}`),
+		},
+		"SomeManagers": {
+			reason:   "Only fields of the supplied managers should be merged, whatever their operation.",
+			managers: []string{"kubectl"},
+			want:     merged(`{"f:spec":{"f:replicas":{}}}`),
+		},
+		"NoFields": {
+			reason:   "Nil should be returned if the managers manage no fields.",
+			managers: []string{"controller"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := MergedFieldsEntry(fields, "apps/v1", tc.managers, "import")
+			if err != nil {
+				t.Fatalf("MergedFieldsEntry(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nMergedFieldsEntry(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
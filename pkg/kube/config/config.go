@@ -19,7 +19,13 @@ limitations under the License.
 // +kubebuilder:object:generate=true
 package config
 
-import xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane/apis/v2/core/v2"
+)
 
 // IdentityType used to authenticate to the Kubernetes API.
 // +kubebuilder:validation:Enum=GoogleApplicationCredentials;AzureServicePrincipalCredentials;AzureWorkloadIdentityCredentials;UpboundTokens;AWSWebIdentityCredentials;NebiusServiceAccountCredentials
@@ -67,4 +73,229 @@ type ProviderConfigSpec struct {
 	// example by configuring a bearer token source such as OAuth.
 	// +optional
 	Identity *Identity `json:"identity,omitempty"`
+	// NamespacePolicy restricts the namespaces of the target cluster that
+	// namespaced Objects using this provider config write into. It does not
+	// apply to cluster-scoped Objects.
+	// +optional
+	NamespacePolicy *NamespacePolicy `json:"namespacePolicy,omitempty"`
+	// Guardrails restrict what the Objects using this provider config may
+	// write into the target cluster. They are enforced before any change is
+	// made to the target cluster.
+	// +optional
+	Guardrails *Guardrails `json:"guardrails,omitempty"`
+	// ValidationRules the manifests of the Objects using this provider
+	// config must satisfy. They are evaluated against the desired manifest
+	// before any change is made to the target cluster. Rules that do not
+	// compile are rejected when the provider config is created or updated.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ValidationRules []ValidationRule `json:"validationRules,omitempty"`
+}
+
+// A ValidationRule is a CEL expression a manifest must satisfy.
+type ValidationRule struct {
+	// Name of the rule, which is reported if a manifest violates it.
+	Name string `json:"name"`
+
+	// Rule is a CEL expression that must evaluate to true for the manifest
+	// to be valid. The manifest is available as the variable 'object', e.g.
+	// object.spec.template.spec.containers.all(c, has(c.resources.limits)).
+	// Rules whose estimated cost is too high are rejected, and evaluation
+	// is aborted once it exceeds the cost limit.
+	Rule string `json:"rule"`
+
+	// Message reported if a manifest violates the rule. Defaults to the
+	// rule itself.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Kinds the rule applies to. It applies to any kind if empty.
+	// +optional
+	Kinds []KindSelector `json:"kinds,omitempty"`
+}
+
+// AppliesTo returns true if the rule applies to the supplied kind.
+func (r ValidationRule) AppliesTo(gvk schema.GroupVersionKind) bool {
+	if len(r.Kinds) == 0 {
+		return true
+	}
+	for _, s := range r.Kinds {
+		if s.Matches(gvk) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClusterScopedKindsPolicy defines whether cluster-scoped kinds may be
+// managed.
+type ClusterScopedKindsPolicy string
+
+// Cluster-scoped kinds policies.
+const (
+	ClusterScopedKindsAllow ClusterScopedKindsPolicy = "Allow"
+	ClusterScopedKindsDeny  ClusterScopedKindsPolicy = "Deny"
+)
+
+// Guardrails restrict the kinds and namespaces of the target cluster, and the
+// number of Objects, a provider config may be used for.
+type Guardrails struct {
+	// AllowedKinds that may be managed. Any kind that is not denied is
+	// allowed if empty.
+	// +optional
+	AllowedKinds []KindSelector `json:"allowedKinds,omitempty"`
+
+	// DeniedKinds that may not be managed, even if they are allowed.
+	// +optional
+	DeniedKinds []KindSelector `json:"deniedKinds,omitempty"`
+
+	// AllowedNamespaces of the target cluster that may be written into. Any
+	// namespace is allowed if empty. For namespaced Objects, they apply to
+	// the namespace determined by the namespace policy.
+	// +optional
+	// +listType=set
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+
+	// ClusterScopedKinds defines whether cluster-scoped kinds, e.g.
+	// Namespaces or ClusterRoles, may be managed.
+	// +optional
+	// +kubebuilder:validation:Enum=Allow;Deny
+	// +kubebuilder:default=Allow
+	ClusterScopedKinds ClusterScopedKindsPolicy `json:"clusterScopedKinds,omitempty"`
+
+	// MaxObjects is the maximum number of resources that may use this
+	// provider config, as recorded by its ProviderConfigUsages. Resources
+	// beyond the limit, in the order they started using the provider config,
+	// may not create or adopt anything.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxObjects *int64 `json:"maxObjects,omitempty"`
+}
+
+// A KindSelector selects kinds of the target cluster.
+type KindSelector struct {
+	// Group of the kind. The empty string selects the core group, while "*"
+	// selects any group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version of the kind. Any version is selected if empty or "*".
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Kind to select, or "*" to select any kind of the group.
+	Kind string `json:"kind"`
+}
+
+// Matches returns true if the supplied kind is selected.
+func (s KindSelector) Matches(gvk schema.GroupVersionKind) bool {
+	return (s.Group == "*" || s.Group == gvk.Group) &&
+		(s.Version == "" || s.Version == "*" || s.Version == gvk.Version) &&
+		(s.Kind == "*" || s.Kind == gvk.Kind)
+}
+
+// AllowsKind returns true if the supplied kind may be managed.
+func (g *Guardrails) AllowsKind(gvk schema.GroupVersionKind) bool {
+	for _, s := range g.DeniedKinds {
+		if s.Matches(gvk) {
+			return false
+		}
+	}
+	if len(g.AllowedKinds) == 0 {
+		return true
+	}
+	for _, s := range g.AllowedKinds {
+		if s.Matches(gvk) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsNamespace returns true if the supplied namespace of the target
+// cluster may be written into.
+func (g *Guardrails) AllowsNamespace(namespace string) bool {
+	return namespace == "" || len(g.AllowedNamespaces) == 0 || slices.Contains(g.AllowedNamespaces, namespace)
+}
+
+// A PolicyViolation records a resource that violates the policies of the
+// provider config it uses.
+type PolicyViolation struct {
+	// Kind of the violating resource.
+	Kind string `json:"kind"`
+	// Namespace of the violating resource, if it is namespaced.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the violating resource.
+	Name string `json:"name"`
+	// Reason the resource violates the policies.
+	Reason string `json:"reason"`
+	// Message describing the violation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// A NamespacePolicy determines the namespace of the target cluster a
+// namespace-scoped manifest of a namespaced Object is written into, and which
+// namespaces are allowed at all.
+type NamespacePolicy struct {
+	// InheritObjectNamespace sets the namespace of a namespace-scoped
+	// manifest that omits one to the namespace of its Object.
+	// +optional
+	InheritObjectNamespace bool `json:"inheritObjectNamespace,omitempty"`
+
+	// Mappings map namespaces of the control plane to namespaces of the
+	// target cluster. A namespace-scoped manifest of an Object in a mapped
+	// namespace that omits its namespace, or sets it to the namespace of the
+	// Object, is written into the mapped namespace instead.
+	// +optional
+	// +listType=map
+	// +listMapKey=from
+	Mappings []NamespaceMapping `json:"mappings,omitempty"`
+
+	// AllowedNamespaces of the target cluster, after inheritance and mapping.
+	// A manifest targeting any other namespace is rejected. Any namespace is
+	// allowed if empty.
+	// +optional
+	// +listType=set
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// A NamespaceMapping maps a namespace of the control plane to a namespace of
+// the target cluster.
+type NamespaceMapping struct {
+	// From is the namespace of the Object in the control plane.
+	From string `json:"from"`
+	// To is the namespace of the target cluster.
+	To string `json:"to"`
+}
+
+// TargetNamespace returns the namespace of the target cluster a manifest
+// with the supplied namespace, of an Object in the supplied namespace, is
+// written into. The supplied namespace is returned unchanged unless it is
+// empty or the namespace of the Object. An empty namespace is only replaced
+// if namespaced is true, i.e. the manifest is namespace-scoped.
+func (p *NamespacePolicy) TargetNamespace(objectNamespace, namespace string, namespaced bool) string {
+	if namespace != "" && namespace != objectNamespace {
+		return namespace
+	}
+	if namespace == "" && !namespaced {
+		return namespace
+	}
+	for _, m := range p.Mappings {
+		if m.From == objectNamespace {
+			return m.To
+		}
+	}
+	if namespace == "" && p.InheritObjectNamespace {
+		return objectNamespace
+	}
+	return namespace
+}
+
+// Allows returns true if manifests may be written into the supplied
+// namespace of the target cluster.
+func (p *NamespacePolicy) Allows(namespace string) bool {
+	return namespace == "" || len(p.AllowedNamespaces) == 0 || slices.Contains(p.AllowedNamespaces, namespace)
 }